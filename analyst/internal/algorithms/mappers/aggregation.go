@@ -9,6 +9,7 @@ import (
 
 	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
 	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/envfilter"
 )
 
 type Aggregation struct {
@@ -38,7 +39,7 @@ func (a Aggregation) Map(value []byte) (key string, output []byte, err error) {
 	}
 
 	f := a.extractValue(e)
-	t := time.Unix(0, e.Timestamp).
+	t := time.Unix(0, envfilter.Timestamp(a.info.GetQuery().GetFilter(), e)).
 		Truncate(time.Duration(a.info.BucketWidthNs)).
 		UnixNano()
 
@@ -52,6 +53,9 @@ func (a Aggregation) Map(value []byte) (key string, output []byte, err error) {
 func (a Aggregation) extractValue(e *loggregator.Envelope) float64 {
 	switch x := a.info.GetQuery().GetFilter().Envelopes.(type) {
 	case *v1.AnalystFilter_Counter:
+		if d, ok := e.GetCounter().GetValue().(*loggregator.Counter_Delta); ok {
+			return float64(d.Delta)
+		}
 		return float64(e.GetCounter().GetTotal())
 	case *v1.AnalystFilter_Gauge:
 		return e.GetGauge().GetMetrics()[x.Gauge.GetName()].GetValue()
@@ -5,9 +5,11 @@ import (
 	"math"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/poy/loggrebutterfly/analyst/internal/algorithms/mappers"
 	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
 	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/ingest"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -61,6 +63,35 @@ func TestAggregation(t *testing.T) {
 			Expect(t, key).To(Equal("98"))
 		})
 
+		o.Spec("it buckets on the ingest timestamp when requested", func(t TA) {
+			req := &v1.AggregateInfo{
+				BucketWidthNs: 2,
+				Query: &v1.QueryInfo{
+					Filter: &v1.AnalystFilter{
+						SourceId: "some-id",
+						Envelopes: &v1.AnalystFilter_Counter{
+							Counter: &v1.CounterFilter{
+								Name: "some-name",
+							},
+						},
+						TimestampSource: v1.TimestampSource_INGEST,
+					},
+				},
+			}
+			agg, err := mappers.NewAggregation(req, t.mockFilter)
+			Expect(t, err == nil).To(BeTrue())
+
+			var e loggregator.Envelope
+			Expect(t, proto.Unmarshal(buildCounter("some-name", "some-id", 99), &e) == nil).To(BeTrue())
+			e.Tags = map[string]*loggregator.Value{
+				ingest.TimestampTag: {Data: &loggregator.Value_Integer{Integer: 201}},
+			}
+
+			t.mockFilter.FilterOutput.Keep <- true
+			key, _, _ := agg.Map(marshalEnvelope(&e))
+			Expect(t, key).To(Equal("200"))
+		})
+
 		o.Spec("it uses an empty key for filtered out envelopes", func(t TA) {
 			t.mockFilter.FilterOutput.Keep <- false
 			e := marshalEnvelope(&loggregator.Envelope{SourceId: "some-id", Timestamp: 99})
@@ -78,6 +109,25 @@ func TestAggregation(t *testing.T) {
 			Expect(t, float).To(Equal(float64(999)))
 		})
 
+		o.Spec("it returns the delta of a delta counter", func(t TA) {
+			t.mockFilter.FilterOutput.Keep <- true
+			e := marshalEnvelope(&loggregator.Envelope{
+				SourceId:  "some-id",
+				Timestamp: 99,
+				Message: &loggregator.Envelope_Counter{
+					Counter: &loggregator.Counter{
+						Name:  "some-name",
+						Value: &loggregator.Counter_Delta{Delta: 5},
+					},
+				},
+			})
+			_, value, _ := t.agg.Map(e)
+			bits := binary.LittleEndian.Uint64(value)
+			float := math.Float64frombits(bits)
+
+			Expect(t, float).To(Equal(float64(5)))
+		})
+
 		o.Spec("it returns an error for a non-envelope", func(t TA) {
 			_, _, err := t.agg.Map([]byte("invalid"))
 			Expect(t, err == nil).To(BeFalse())
@@ -6,6 +6,7 @@ import (
 	"github.com/poy/loggrebutterfly/analyst/internal/algorithms/mappers"
 	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
 	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/ingest"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -72,6 +73,63 @@ func TestFilterTimerange(t *testing.T) {
 	})
 }
 
+func TestFilterIngestTimerange(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TF {
+		req := &v1.QueryInfo{
+			Filter: &v1.AnalystFilter{
+				SourceId: "some-id",
+				TimeRange: &v1.TimeRange{
+					Start: 99,
+					End:   101,
+				},
+				TimestampSource: v1.TimestampSource_INGEST,
+			},
+		}
+
+		f, err := mappers.NewFilter(&v1.AggregateInfo{Query: req})
+		Expect(t, err == nil).To(BeTrue())
+
+		return TF{
+			T:  t,
+			tr: f,
+		}
+	})
+
+	o.Spec("it filters on the ingest timestamp", func(t TF) {
+		e1 := &loggregator.Envelope{
+			SourceId:  "some-id",
+			Timestamp: 99,
+			Tags: map[string]*loggregator.Value{
+				ingest.TimestampTag: {Data: &loggregator.Value_Integer{Integer: 200}},
+			},
+		}
+		e2 := &loggregator.Envelope{
+			SourceId:  "some-id",
+			Timestamp: 50,
+			Tags: map[string]*loggregator.Value{
+				ingest.TimestampTag: {Data: &loggregator.Value_Integer{Integer: 100}},
+			},
+		}
+
+		keep := t.tr.Filter(e1)
+		Expect(t, keep).To(BeFalse())
+
+		keep = t.tr.Filter(e2)
+		Expect(t, keep).To(BeTrue())
+	})
+
+	o.Spec("it falls back to the producer timestamp", func(t TF) {
+		e := &loggregator.Envelope{SourceId: "some-id", Timestamp: 100}
+
+		keep := t.tr.Filter(e)
+		Expect(t, keep).To(BeTrue())
+	})
+}
+
 func TestFilterLogFilter(t *testing.T) {
 	t.Parallel()
 	o := onpar.New()
@@ -4,6 +4,8 @@ import (
 	"strconv"
 
 	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hlc"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -12,11 +14,13 @@ type Filter interface {
 }
 
 type Query struct {
+	info   *v1.AggregateInfo
 	filter Filter
 }
 
-func NewQuery(filter Filter) Query {
+func NewQuery(info *v1.AggregateInfo, filter Filter) Query {
 	return Query{
+		info:   info,
 		filter: filter,
 	}
 }
@@ -27,6 +31,10 @@ func (r Query) Map(value []byte) (key string, output []byte, err error) {
 		return "", nil, err
 	}
 
+	if r.info.GetQuery().GetOrder() == v1.QueryOrder_HLC {
+		return hlc.Of(e).String(), value, nil
+	}
+
 	return strconv.FormatInt(e.Timestamp, 10), value, nil
 }
 
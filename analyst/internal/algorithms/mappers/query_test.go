@@ -6,9 +6,12 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/poy/loggrebutterfly/analyst/internal/algorithms/mappers"
 	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hlc"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -41,7 +44,7 @@ func TestQuery(t *testing.T) {
 
 			return TTR{
 				T:          t,
-				tr:         mappers.NewQuery(mockFilter),
+				tr:         mappers.NewQuery(&v1.AggregateInfo{}, mockFilter),
 				mockFilter: mockFilter,
 			}
 		})
@@ -53,6 +56,20 @@ func TestQuery(t *testing.T) {
 			Expect(t, key).To(Equal("99"))
 		})
 
+		o.Spec("it uses the hybrid logical clock value as a key when ordered by it", func(t TTR) {
+			tr := mappers.NewQuery(&v1.AggregateInfo{
+				Query: &v1.QueryInfo{Order: v1.QueryOrder_HLC},
+			}, t.mockFilter)
+			clock := hlc.NewClock("some-node", func() time.Time {
+				return time.Unix(0, 101)
+			})
+
+			t.mockFilter.FilterOutput.Keep <- true
+			e := clock.Stamp(&loggregator.Envelope{SourceId: "some-id", Timestamp: 99})
+			key, _, _ := tr.Map(marshalEnvelope(e))
+			Expect(t, key).To(Equal(hlc.Of(e).String()))
+		})
+
 		o.Spec("it uses an empty key for filtered out envelopes", func(t TTR) {
 			t.mockFilter.FilterOutput.Keep <- false
 			e := marshalEnvelope(&loggregator.Envelope{SourceId: "some-id", Timestamp: 99})
@@ -2,8 +2,10 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/bradylove/envstruct"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 )
 
 type Config struct {
@@ -15,12 +17,38 @@ type Config struct {
 	IntraAnalystList     []string `env:"INTRA_ANALYST_LIST,required"`
 	PprofAddr            string   `env:"PPROF_ADDR"`
 
-	ToAnalyst map[string]string
+	// TalariaNodeURI is the URI the scheduler lists for the talaria node at
+	// TALARIA_NODE_ADDR. Files that are not on it are read from another
+	// replica. If it is empty, every file is read from the local node
+	// first.
+	TalariaNodeURI string `env:"TALARIA_NODE_URI"`
+
+	// HotSourceList is a list of source_id:tag pairs. It must match the
+	// data nodes' HOT_SOURCE_LIST.
+	HotSourceList []string `env:"HOT_SOURCE_LIST"`
+
+	// IntraMaxConcurrency is the number of calls that can be in flight to
+	// each peer analyst.
+	IntraMaxConcurrency int `env:"INTRA_MAX_CONCURRENCY"`
+
+	// ExecuteTimeout is how long a peer has to execute a file before the
+	// file's next replica is tried.
+	ExecuteTimeout time.Duration `env:"EXECUTE_TIMEOUT"`
+
+	// HedgeDelay is how long a peer can take to execute a file before the
+	// file is also executed on its next replica.
+	HedgeDelay time.Duration `env:"HEDGE_DELAY"`
+
+	ToAnalyst  map[string]string
+	HotSources map[string]string
 }
 
 func Load() *Config {
 	conf := Config{
-		PprofAddr: "localhost:0",
+		PprofAddr:           "localhost:0",
+		IntraMaxConcurrency: 16,
+		ExecuteTimeout:      30 * time.Second,
+		HedgeDelay:          2 * time.Second,
 	}
 
 	if err := envstruct.Load(&conf); err != nil {
@@ -36,5 +64,11 @@ func Load() *Config {
 		conf.ToAnalyst[conf.TalariaNodeList[i]] = conf.IntraAnalystList[i]
 	}
 
+	hotSources, err := hashing.ParseHotSources(conf.HotSourceList)
+	if err != nil {
+		log.Fatalf("Invalid HOT_SOURCE_LIST: %s", err)
+	}
+	conf.HotSources = hotSources
+
 	return &conf
 }
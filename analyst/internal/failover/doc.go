@@ -0,0 +1,3 @@
+package failover
+
+//go:generate hel
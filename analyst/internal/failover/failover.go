@@ -0,0 +1,175 @@
+package failover
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+type FileSystem interface {
+	Files(route string, ctx context.Context, meta []byte) (files map[string][]string, err error)
+	Reader(file string, ctx context.Context, meta []byte) (reader func() (data []byte, err error), err error)
+}
+
+type Network interface {
+	Execute(file, algName, nodeID string, ctx context.Context, meta []byte) (result map[string][]byte, err error)
+}
+
+type Option func(*Failover)
+
+// WithTimeout sets how long a replica has to execute a file before the
+// next replica is tried. Defaults to 30 seconds.
+func WithTimeout(d time.Duration) Option {
+	return func(f *Failover) {
+		f.timeout = d
+	}
+}
+
+// WithHedgeDelay sets how long a replica can take before the file is also
+// executed on the next replica. The first result is used. Defaults to 2
+// seconds.
+func WithHedgeDelay(d time.Duration) Option {
+	return func(f *Failover) {
+		f.hedgeDelay = d
+	}
+}
+
+// Failover executes a file on its other replicas when the chosen one
+// fails, times out or is slow. It records the replicas of each file as the
+// files are listed, so it is used as both the FileSystem and the Network
+// of a MapReduce.
+//
+// A file that fails on every replica fails the calculation. If the
+// context has a Report, the file is left out of the result and added to
+// the report instead.
+type Failover struct {
+	fs         FileSystem
+	network    Network
+	timeout    time.Duration
+	hedgeDelay time.Duration
+
+	mu       sync.Mutex
+	replicas map[string][]string
+}
+
+func New(fs FileSystem, n Network, opts ...Option) *Failover {
+	f := &Failover{
+		fs:         fs,
+		network:    n,
+		timeout:    30 * time.Second,
+		hedgeDelay: 2 * time.Second,
+		replicas:   make(map[string][]string),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+func (f *Failover) Files(route string, ctx context.Context, meta []byte) (files map[string][]string, err error) {
+	files, err = f.fs.Files(route, ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for file, nodes := range files {
+		f.replicas[file] = append([]string(nil), nodes...)
+	}
+
+	return files, nil
+}
+
+func (f *Failover) Reader(file string, ctx context.Context, meta []byte) (reader func() (data []byte, err error), err error) {
+	return f.fs.Reader(file, ctx, meta)
+}
+
+type attempt struct {
+	nodeID string
+	result map[string][]byte
+	err    error
+}
+
+// Execute executes the file on the given node. If it fails or times out
+// the next replica is tried. If it takes longer than the hedge delay, the
+// next replica is tried as well.
+func (f *Failover) Execute(file, algName, nodeID string, ctx context.Context, meta []byte) (result map[string][]byte, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	nodes := f.nodes(file, nodeID)
+	attempts := make(chan attempt, len(nodes))
+	start := func() {
+		n := nodes[0]
+		nodes = nodes[1:]
+
+		go func() {
+			attemptCtx, cancel := context.WithTimeout(ctx, f.timeout)
+			defer cancel()
+
+			result, err := f.network.Execute(file, algName, n, attemptCtx, meta)
+			attempts <- attempt{nodeID: n, result: result, err: err}
+		}()
+	}
+
+	start()
+	pending := 1
+
+	hedge := time.NewTimer(f.hedgeDelay)
+	defer hedge.Stop()
+
+	var errs []string
+	for pending > 0 {
+		select {
+		case a := <-attempts:
+			pending--
+			if a.err == nil {
+				return a.result, nil
+			}
+
+			log.Printf("Failed to execute %s on %s: %s", file, a.nodeID, a.err)
+			errs = append(errs, fmt.Sprintf("%s: %s", a.nodeID, a.err))
+			if len(nodes) > 0 && ctx.Err() == nil {
+				start()
+				pending++
+			}
+		case <-hedge.C:
+			if len(nodes) > 0 {
+				start()
+				pending++
+				hedge.Reset(f.hedgeDelay)
+			}
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	reason := strings.Join(errs, ", ")
+	log.Printf("Failed to execute %s on every replica: %s", file, reason)
+	if !reportFailure(ctx, file, reason) {
+		return nil, fmt.Errorf("failed to execute %s on every replica: %s", file, reason)
+	}
+
+	return nil, nil
+}
+
+// nodes returns the given node followed by the file's other replicas.
+func (f *Failover) nodes(file, nodeID string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	nodes := []string{nodeID}
+	for _, n := range f.replicas[file] {
+		if n != nodeID {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
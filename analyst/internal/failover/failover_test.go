@@ -0,0 +1,150 @@
+package failover_test
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/poy/loggrebutterfly/analyst/internal/failover"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TF struct {
+	*testing.T
+	mockFileSystem *mockFileSystem
+	mockNetwork    *mockNetwork
+	f              *failover.Failover
+}
+
+func TestFailover(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TF {
+		mockFileSystem := newMockFileSystem()
+		mockNetwork := newMockNetwork()
+
+		mockFileSystem.FilesOutput.Files <- map[string][]string{
+			"some-file": {"a", "b", "c"},
+		}
+		mockFileSystem.FilesOutput.Err <- nil
+
+		f := failover.New(mockFileSystem, mockNetwork,
+			failover.WithTimeout(time.Second),
+			failover.WithHedgeDelay(500*time.Millisecond),
+		)
+		_, err := f.Files("some-route", context.Background(), nil)
+		Expect(t, err == nil).To(BeTrue())
+
+		return TF{
+			T:              t,
+			mockFileSystem: mockFileSystem,
+			mockNetwork:    mockNetwork,
+			f:              f,
+		}
+	})
+
+	o.Spec("it executes on the given node", func(t TF) {
+		t.mockNetwork.ExecuteOutput.Result <- map[string][]byte{"x": []byte("y")}
+		t.mockNetwork.ExecuteOutput.Err <- nil
+
+		result, err := t.f.Execute("some-file", "some-alg", "b", context.Background(), []byte("meta"))
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, result).To(Equal(map[string][]byte{"x": []byte("y")}))
+		Expect(t, t.mockNetwork.ExecuteInput.NodeID).To(Chain(Receive(), Equal("b")))
+		Expect(t, t.mockNetwork.ExecuteInput.Meta).To(Chain(Receive(), Equal([]byte("meta"))))
+	})
+
+	o.Spec("it tries the other replicas when a node fails", func(t TF) {
+		t.mockNetwork.ExecuteOutput.Result <- nil
+		t.mockNetwork.ExecuteOutput.Err <- fmt.Errorf("some-error")
+		t.mockNetwork.ExecuteOutput.Result <- map[string][]byte{"x": []byte("y")}
+		t.mockNetwork.ExecuteOutput.Err <- nil
+
+		result, err := t.f.Execute("some-file", "some-alg", "b", context.Background(), nil)
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, result).To(Equal(map[string][]byte{"x": []byte("y")}))
+		Expect(t, t.mockNetwork.ExecuteInput.NodeID).To(Chain(Receive(), Equal("b")))
+		Expect(t, t.mockNetwork.ExecuteInput.NodeID).To(Chain(Receive(), Equal("a")))
+	})
+
+	o.Spec("it hedges a slow node", func(t TF) {
+		done := make(chan map[string][]byte)
+		go func() {
+			result, _ := t.f.Execute("some-file", "some-alg", "b", context.Background(), nil)
+			done <- result
+		}()
+
+		Expect(t, t.mockNetwork.ExecuteInput.NodeID).To(ViaPolling(
+			Chain(Receive(), Equal("b")),
+		))
+		Expect(t, t.mockNetwork.ExecuteInput.NodeID).To(ViaPollingMatcher{
+			Matcher:  Chain(Receive(), Equal("a")),
+			Duration: 5 * time.Second,
+		})
+
+		t.mockNetwork.ExecuteOutput.Result <- map[string][]byte{"x": []byte("y")}
+		t.mockNetwork.ExecuteOutput.Err <- nil
+		Expect(t, done).To(ViaPollingMatcher{
+			Matcher:  Chain(Receive(), Equal(map[string][]byte{"x": []byte("y")})),
+			Duration: 5 * time.Second,
+		})
+	})
+
+	o.Spec("it reports a file that fails on every replica", func(t TF) {
+		for i := 0; i < 3; i++ {
+			t.mockNetwork.ExecuteOutput.Result <- nil
+			t.mockNetwork.ExecuteOutput.Err <- fmt.Errorf("some-error")
+		}
+
+		ctx, report := failover.NewReport(context.Background())
+		result, err := t.f.Execute("some-file", "some-alg", "b", ctx, nil)
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, result).To(HaveLen(0))
+		Expect(t, report.Partial()).To(BeTrue())
+
+		failures := report.Failures()
+		Expect(t, failures).To(HaveLen(1))
+		Expect(t, failures[0].File).To(Equal("some-file"))
+		Expect(t, failures[0].Reason).To(Equal("b: some-error, a: some-error, c: some-error"))
+	})
+
+	o.Spec("it returns an error for a file that fails on every replica without a report", func(t TF) {
+		for i := 0; i < 3; i++ {
+			t.mockNetwork.ExecuteOutput.Result <- nil
+			t.mockNetwork.ExecuteOutput.Err <- fmt.Errorf("some-error")
+		}
+
+		_, err := t.f.Execute("some-file", "some-alg", "b", context.Background(), nil)
+		Expect(t, err == nil).To(BeFalse())
+	})
+
+	o.Spec("it returns an error when the context is done", func(t TF) {
+		t.mockNetwork.ExecuteOutput.Result <- nil
+		t.mockNetwork.ExecuteOutput.Err <- context.Canceled
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := t.f.Execute("some-file", "some-alg", "b", ctx, nil)
+		Expect(t, err).To(Equal(context.Canceled))
+	})
+}
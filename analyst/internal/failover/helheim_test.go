@@ -0,0 +1,99 @@
+// This file was generated by github.com/nelsam/hel.  Do not
+// edit this code by hand unless you *really* know what you're
+// doing.  Expect any changes made manually to be overwritten
+// the next time hel regenerates this file.
+
+package failover_test
+
+import "golang.org/x/net/context"
+
+type mockFileSystem struct {
+	FilesCalled chan bool
+	FilesInput  struct {
+		Route chan string
+		Ctx   chan context.Context
+		Meta  chan []byte
+	}
+	FilesOutput struct {
+		Files chan map[string][]string
+		Err   chan error
+	}
+	ReaderCalled chan bool
+	ReaderInput  struct {
+		File chan string
+		Ctx  chan context.Context
+		Meta chan []byte
+	}
+	ReaderOutput struct {
+		Reader chan func() (data []byte, err error)
+		Err    chan error
+	}
+}
+
+func newMockFileSystem() *mockFileSystem {
+	m := &mockFileSystem{}
+	m.FilesCalled = make(chan bool, 100)
+	m.FilesInput.Route = make(chan string, 100)
+	m.FilesInput.Ctx = make(chan context.Context, 100)
+	m.FilesInput.Meta = make(chan []byte, 100)
+	m.FilesOutput.Files = make(chan map[string][]string, 100)
+	m.FilesOutput.Err = make(chan error, 100)
+	m.ReaderCalled = make(chan bool, 100)
+	m.ReaderInput.File = make(chan string, 100)
+	m.ReaderInput.Ctx = make(chan context.Context, 100)
+	m.ReaderInput.Meta = make(chan []byte, 100)
+	m.ReaderOutput.Reader = make(chan func() (data []byte, err error), 100)
+	m.ReaderOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockFileSystem) Files(route string, ctx context.Context, meta []byte) (files map[string][]string, err error) {
+	m.FilesCalled <- true
+	m.FilesInput.Route <- route
+	m.FilesInput.Ctx <- ctx
+	m.FilesInput.Meta <- meta
+	return <-m.FilesOutput.Files, <-m.FilesOutput.Err
+}
+func (m *mockFileSystem) Reader(file string, ctx context.Context, meta []byte) (reader func() (data []byte, err error), err error) {
+	m.ReaderCalled <- true
+	m.ReaderInput.File <- file
+	m.ReaderInput.Ctx <- ctx
+	m.ReaderInput.Meta <- meta
+	return <-m.ReaderOutput.Reader, <-m.ReaderOutput.Err
+}
+
+type mockNetwork struct {
+	ExecuteCalled chan bool
+	ExecuteInput  struct {
+		File    chan string
+		AlgName chan string
+		NodeID  chan string
+		Ctx     chan context.Context
+		Meta    chan []byte
+	}
+	ExecuteOutput struct {
+		Result chan map[string][]byte
+		Err    chan error
+	}
+}
+
+func newMockNetwork() *mockNetwork {
+	m := &mockNetwork{}
+	m.ExecuteCalled = make(chan bool, 100)
+	m.ExecuteInput.File = make(chan string, 100)
+	m.ExecuteInput.AlgName = make(chan string, 100)
+	m.ExecuteInput.NodeID = make(chan string, 100)
+	m.ExecuteInput.Ctx = make(chan context.Context, 100)
+	m.ExecuteInput.Meta = make(chan []byte, 100)
+	m.ExecuteOutput.Result = make(chan map[string][]byte, 100)
+	m.ExecuteOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockNetwork) Execute(file, algName, nodeID string, ctx context.Context, meta []byte) (result map[string][]byte, err error) {
+	m.ExecuteCalled <- true
+	m.ExecuteInput.File <- file
+	m.ExecuteInput.AlgName <- algName
+	m.ExecuteInput.NodeID <- nodeID
+	m.ExecuteInput.Ctx <- ctx
+	m.ExecuteInput.Meta <- meta
+	return <-m.ExecuteOutput.Result, <-m.ExecuteOutput.Err
+}
@@ -0,0 +1,80 @@
+package failover
+
+import (
+	"sort"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+type reportKey struct{}
+
+// Failure is a file that could not be executed on any replica.
+type Failure struct {
+	File   string
+	Reason string
+}
+
+// Report collects the files that could not be executed on any replica
+// while calculating a best effort result.
+type Report struct {
+	mu     sync.Mutex
+	failed map[string]string
+}
+
+// NewReport returns a context for best effort calculations. A file that
+// fails on every replica is left out of the result and added to the
+// report instead of failing the calculation.
+func NewReport(ctx context.Context) (context.Context, *Report) {
+	r := &Report{
+		failed: make(map[string]string),
+	}
+	return context.WithValue(ctx, reportKey{}, r), r
+}
+
+// Failures returns the failed files sorted by name. A nil Report has no
+// failures.
+func (r *Report) Failures() []Failure {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var failures []Failure
+	for file, reason := range r.failed {
+		failures = append(failures, Failure{File: file, Reason: reason})
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].File < failures[j].File
+	})
+
+	return failures
+}
+
+// Partial reports whether a file failed.
+func (r *Report) Partial() bool {
+	if r == nil {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.failed) > 0
+}
+
+// reportFailure adds the file to the context's report. It returns false
+// if the context does not have a report.
+func reportFailure(ctx context.Context, file, reason string) bool {
+	r, ok := ctx.Value(reportKey{}).(*Report)
+	if !ok {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed[file] = reason
+
+	return true
+}
@@ -1,8 +1,10 @@
 package filesystem
 
 import (
+	"fmt"
 	"io"
 	"log"
+	"sync"
 
 	talaria "github.com/poy/talaria/api/v1"
 	"golang.org/x/net/context"
@@ -13,22 +15,64 @@ type FileFilter interface {
 	Filter(route string, files map[string][]string)
 }
 
+type Option func(*FileSystem)
+
+// WithLocalURI sets the URI the scheduler lists for the local talaria
+// node. Files that are not on the local node are read from their other
+// replicas without trying the local node first.
+func WithLocalURI(uri string) Option {
+	return func(f *FileSystem) {
+		f.localURI = uri
+	}
+}
+
+// WithLocalAddr sets the address the local node client was dialed with.
+// Without a local URI, the replica at that address is skipped after the
+// local node failed to read the file.
+func WithLocalAddr(addr string) Option {
+	return func(f *FileSystem) {
+		f.localAddr = addr
+	}
+}
+
+// WithDialer sets how clients for the other talaria nodes are created.
+func WithDialer(dial func(uri string) (talaria.NodeClient, error)) Option {
+	return func(f *FileSystem) {
+		f.dial = dial
+	}
+}
+
 type FileSystem struct {
 	filter      FileFilter
 	schedClient talaria.SchedulerClient
 	nodeClient  talaria.NodeClient
+	localURI    string
+	localAddr   string
+	dial        func(uri string) (talaria.NodeClient, error)
+
+	toAnalyst map[string]string
 
-	toAnalyst   map[string]string
-	fromAnalyst map[string]string
+	mu       sync.Mutex
+	replicas map[string][]string
+	misses   map[string]bool
+	clients  map[string]talaria.NodeClient
 }
 
-func New(f FileFilter, s talaria.SchedulerClient, n talaria.NodeClient, toAnalyst map[string]string) *FileSystem {
-	return &FileSystem{
+func New(f FileFilter, s talaria.SchedulerClient, n talaria.NodeClient, toAnalyst map[string]string, opts ...Option) *FileSystem {
+	fs := &FileSystem{
 		filter:      f,
 		schedClient: s,
 		nodeClient:  n,
 		toAnalyst:   toAnalyst,
+		dial:        dialNode,
+		replicas:    make(map[string][]string),
+		misses:      make(map[string]bool),
+		clients:     make(map[string]talaria.NodeClient),
 	}
+	for _, opt := range opts {
+		opt(fs)
+	}
+	return fs
 }
 
 func (f *FileSystem) Files(route string, ctx context.Context, meta []byte) (files map[string][]string, err error) {
@@ -43,23 +87,142 @@ func (f *FileSystem) Files(route string, ctx context.Context, meta []byte) (file
 	return files, nil
 }
 
+// Reader reads the file from the talaria node that holds it. The local
+// node is preferred and the file's other replicas are tried if it fails.
+// Without a local URI, the local node is tried before the replicas are
+// looked up and is not tried again.
 func (f *FileSystem) Reader(file string, ctx context.Context, meta []byte) (reader func() (data []byte, err error), err error) {
-	rx, err := f.nodeClient.Read(ctx, &talaria.BufferInfo{Name: file})
+	var lastErr error
+	if f.localURI == "" {
+		reader, err := f.read(f.nodeClient, file, ctx)
+		if err == nil {
+			return reader, nil
+		}
+		log.Printf("Failed to read %s from the local node: %s", file, err)
+		lastErr = err
+	}
+
+	uris, err := f.replicasOf(file, ctx)
 	if err != nil {
+		if lastErr != nil {
+			return nil, lastErr
+		}
 		return nil, err
 	}
 
+	for _, uri := range uris {
+		if f.localURI == "" && uri == f.localAddr {
+			continue
+		}
+
+		client := f.nodeClient
+		if uri != f.localURI {
+			client, err = f.client(uri)
+			if err != nil {
+				log.Printf("Failed to connect to %s: %s", uri, err)
+				lastErr = err
+				continue
+			}
+		}
+
+		reader, err := f.read(client, file, ctx)
+		if err == nil {
+			return reader, nil
+		}
+		log.Printf("Failed to read %s from %s: %s", file, uri, err)
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no replicas of %s", file)
+	}
+	return nil, lastErr
+}
+
+// read opens the file on the node. The first packet is read right away to
+// find out whether the node can serve the file.
+func (f *FileSystem) read(client talaria.NodeClient, file string, ctx context.Context) (reader func() (data []byte, err error), err error) {
+	rx, err := client.Read(ctx, &talaria.BufferInfo{Name: file})
+	if err != nil {
+		return nil, err
+	}
+
+	first, firstErr := recv(rx)
+	if firstErr != nil && firstErr != io.EOF {
+		return nil, firstErr
+	}
+
+	pending := true
 	return func() ([]byte, error) {
-		packet, err := rx.Recv()
-		if grpc.ErrorDesc(err) == "EOF" {
-			return nil, io.EOF
+		if pending {
+			pending = false
+			return first, firstErr
 		}
+		return recv(rx)
+	}, nil
+}
+
+func recv(rx talaria.Node_ReadClient) ([]byte, error) {
+	packet, err := rx.Recv()
+	if grpc.ErrorDesc(err) == "EOF" {
+		return nil, io.EOF
+	}
 
-		if err != nil {
+	if err != nil {
+		return nil, err
+	}
+	return packet.Message, nil
+}
+
+// replicasOf returns the URIs of the nodes that hold the file with the
+// local node first. The replicas are looked up if the file has not been
+// listed yet. A file that is still not listed is remembered until the
+// files are listed again.
+func (f *FileSystem) replicasOf(file string, ctx context.Context) ([]string, error) {
+	f.mu.Lock()
+	uris, ok := f.replicas[file]
+	missed := f.misses[file]
+	f.mu.Unlock()
+
+	if !ok && !missed {
+		if _, err := f.fetchAllFiles(ctx); err != nil {
 			return nil, err
 		}
-		return packet.Message, nil
-	}, nil
+
+		f.mu.Lock()
+		uris, ok = f.replicas[file]
+		if !ok {
+			f.misses[file] = true
+		}
+		f.mu.Unlock()
+	}
+
+	ordered := make([]string, 0, len(uris))
+	for _, uri := range uris {
+		if uri == f.localURI {
+			ordered = append([]string{uri}, ordered...)
+			continue
+		}
+		ordered = append(ordered, uri)
+	}
+	return ordered, nil
+}
+
+func (f *FileSystem) client(uri string) (talaria.NodeClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if client, ok := f.clients[uri]; ok {
+		return client, nil
+	}
+
+	client, err := f.dial(uri)
+	if err != nil {
+		return nil, err
+	}
+	f.clients[uri] = client
+
+	return client, nil
 }
 
 func (f *FileSystem) fetchAllFiles(ctx context.Context) (files map[string][]string, err error) {
@@ -69,12 +232,19 @@ func (f *FileSystem) fetchAllFiles(ctx context.Context) (files map[string][]stri
 	}
 
 	files = make(map[string][]string)
+	replicas := make(map[string][]string)
 	for _, info := range resp.Info {
 		for _, nodeInfo := range info.Nodes {
 			files[info.Name] = append(files[info.Name], nodeInfo.URI)
+			replicas[info.Name] = append(replicas[info.Name], nodeInfo.URI)
 		}
 	}
 
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.replicas = replicas
+	f.misses = make(map[string]bool)
+
 	return files, nil
 }
 
@@ -91,3 +261,11 @@ func (f *FileSystem) swapToAnalyst(m map[string][]string) {
 		}
 	}
 }
+
+func dialNode(uri string) (talaria.NodeClient, error) {
+	conn, err := grpc.Dial(uri, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return talaria.NewNodeClient(conn), nil
+}
@@ -36,6 +36,8 @@ type TFS struct {
 	mockSchedulerClient *mockSchedulerClient
 	mockNodeClient      *mockNodeClient
 	mockNodeReadClient  *mockNodeReadClient
+	dialer              func(uri string) (talaria.NodeClient, error)
+	dialed              chan string
 	fs                  *filesystem.FileSystem
 }
 
@@ -153,6 +155,23 @@ func TestFileSystemReader(t *testing.T) {
 					Chain(Receive(), Equal(&talaria.BufferInfo{Name: "some-file"})),
 				)
 			})
+
+			o.Spec("it prefers the local node when it holds the file", func(t TFS) {
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret0 <- listFile("some-file", "some-node-name-1", "some-node-name-2")
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret1 <- nil
+				fs := filesystem.New(t.mockFilter, t.mockSchedulerClient, t.mockNodeClient, nil,
+					filesystem.WithLocalURI("some-node-name-2"),
+					filesystem.WithDialer(t.dialer),
+				)
+
+				reader, err := fs.Reader("some-file", context.Background(), nil)
+				Expect(t, err == nil).To(BeTrue())
+
+				data, err := reader()
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, data).To(Equal([]byte("some-data")))
+				Expect(t, t.dialed).To(HaveLen(0))
+			})
 		})
 
 		o.Group("node reader returns an error", func() {
@@ -163,9 +182,10 @@ func TestFileSystemReader(t *testing.T) {
 
 			o.Spec("it returns an error", func(t TFS) {
 				t.mockNodeReadClient.RecvOutput.Ret1 <- fmt.Errorf("some-error")
-				reader, err := t.fs.Reader("some-file", context.Background(), nil)
-				Expect(t, err == nil).To(BeTrue())
-				_, err = reader()
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret0 <- nil
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret1 <- fmt.Errorf("some-error")
+
+				_, err := t.fs.Reader("some-file", context.Background(), nil)
 				Expect(t, err == nil).To(BeFalse())
 			})
 
@@ -176,6 +196,40 @@ func TestFileSystemReader(t *testing.T) {
 				_, err = reader()
 				Expect(t, err).To(Equal(io.EOF))
 			})
+
+			o.Spec("it reads from another replica", func(t TFS) {
+				t.mockNodeReadClient.RecvOutput.Ret1 <- fmt.Errorf("some-error")
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret0 <- listFile("some-file", "some-node-name-3")
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret1 <- nil
+
+				reader, err := t.fs.Reader("some-file", context.Background(), nil)
+				Expect(t, err == nil).To(BeTrue())
+
+				data, err := reader()
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, data).To(Equal([]byte("remote-data")))
+				Expect(t, t.dialed).To(Chain(Receive(), Equal("some-node-name-3")))
+			})
+
+			o.Spec("it does not read from the local address again", func(t TFS) {
+				t.mockNodeReadClient.RecvOutput.Ret1 <- fmt.Errorf("some-error")
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret0 <- listFile("some-file", "some-node-name-1", "some-node-name-3")
+				t.mockSchedulerClient.ListClusterInfoOutput.Ret1 <- nil
+				fs := filesystem.New(t.mockFilter, t.mockSchedulerClient, t.mockNodeClient, nil,
+					filesystem.WithLocalAddr("some-node-name-1"),
+					filesystem.WithDialer(t.dialer),
+				)
+
+				reader, err := fs.Reader("some-file", context.Background(), nil)
+				Expect(t, err == nil).To(BeTrue())
+
+				data, err := reader()
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, data).To(Equal([]byte("remote-data")))
+				Expect(t, t.dialed).To(Chain(Receive(), Equal("some-node-name-3")))
+				Expect(t, t.dialed).To(HaveLen(0))
+				Expect(t, t.mockNodeClient.ReadCalled).To(HaveLen(1))
+			})
 		})
 	})
 
@@ -187,10 +241,51 @@ func TestFileSystemReader(t *testing.T) {
 		})
 
 		o.Spec("it returns an error", func(t TFS) {
+			t.mockSchedulerClient.ListClusterInfoOutput.Ret0 <- listFile("some-file")
+			t.mockSchedulerClient.ListClusterInfoOutput.Ret1 <- nil
+
+			_, err := t.fs.Reader("some-file", context.Background(), nil)
+			Expect(t, err == nil).To(BeFalse())
+		})
+
+		o.Spec("it does not list the files again for a file that is not listed", func(t TFS) {
+			t.mockNodeClient.ReadOutput.Ret1 <- fmt.Errorf("some-error")
+			t.mockSchedulerClient.ListClusterInfoOutput.Ret0 <- listFile("other-file", "some-node-name-3")
+			t.mockSchedulerClient.ListClusterInfoOutput.Ret1 <- nil
+
 			_, err := t.fs.Reader("some-file", context.Background(), nil)
 			Expect(t, err == nil).To(BeFalse())
+			_, err = t.fs.Reader("some-file", context.Background(), nil)
+			Expect(t, err == nil).To(BeFalse())
+
+			Expect(t, t.mockSchedulerClient.ListClusterInfoCalled).To(HaveLen(1))
 		})
 	})
+
+	o.Spec("it skips the local node when it does not hold the file", func(t TFS) {
+		t.mockSchedulerClient.ListClusterInfoOutput.Ret0 <- listFile("some-file", "some-node-name-3")
+		t.mockSchedulerClient.ListClusterInfoOutput.Ret1 <- nil
+		fs := filesystem.New(t.mockFilter, t.mockSchedulerClient, t.mockNodeClient, nil,
+			filesystem.WithLocalURI("some-node-name-1"),
+			filesystem.WithDialer(t.dialer),
+		)
+
+		reader, err := fs.Reader("some-file", context.Background(), nil)
+		Expect(t, err == nil).To(BeTrue())
+
+		data, err := reader()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, data).To(Equal([]byte("remote-data")))
+		Expect(t, t.mockNodeClient.ReadCalled).To(HaveLen(0))
+	})
+}
+
+func listFile(name string, uris ...string) *talaria.ListResponse {
+	info := &talaria.ClusterInfo{Name: name}
+	for _, uri := range uris {
+		info.Nodes = append(info.Nodes, &talaria.NodeInfo{URI: uri})
+	}
+	return &talaria.ListResponse{Info: []*talaria.ClusterInfo{info}}
 }
 
 func setup(o *onpar.Onpar) {
@@ -206,13 +301,32 @@ func setup(o *onpar.Onpar) {
 			"some-node-name-3": "translated-3",
 		}
 
+		mockRemoteClient := newMockNodeClient()
+		mockRemoteReadClient := newMockNodeReadClient()
+		mockRemoteClient.ReadOutput.Ret0 <- mockRemoteReadClient
+		mockRemoteClient.ReadOutput.Ret1 <- nil
+		mockRemoteReadClient.RecvOutput.Ret0 <- &talaria.ReadDataPacket{
+			Message: []byte("remote-data"),
+		}
+		mockRemoteReadClient.RecvOutput.Ret1 <- nil
+
+		dialed := make(chan string, 100)
+		dialer := func(uri string) (talaria.NodeClient, error) {
+			dialed <- uri
+			return mockRemoteClient, nil
+		}
+
 		return TFS{
 			T:                   t,
 			mockFilter:          mockFilter,
 			mockSchedulerClient: mockSchedulerClient,
 			mockNodeClient:      mockNodeClient,
 			mockNodeReadClient:  mockNodeReadClient,
-			fs:                  filesystem.New(mockFilter, mockSchedulerClient, mockNodeClient, translate),
+			dialer:              dialer,
+			dialed:              dialed,
+			fs: filesystem.New(mockFilter, mockSchedulerClient, mockNodeClient, translate,
+				filesystem.WithDialer(dialer),
+			),
 		}
 	})
 
@@ -254,24 +254,31 @@ func (m *mockFileFilter) Filter(route string, files map[string][]string) {
 }
 
 type mockHasher struct {
-	HashStringCalled chan bool
-	HashStringInput  struct {
-		S chan string
+	HashRangeCalled chan bool
+	HashRangeInput  struct {
+		Version chan uint64
+		S       chan string
 	}
-	HashStringOutput struct {
-		Hash chan uint64
+	HashRangeOutput struct {
+		Low  chan uint64
+		High chan uint64
+		Err  chan error
 	}
 }
 
 func newMockHasher() *mockHasher {
 	m := &mockHasher{}
-	m.HashStringCalled = make(chan bool, 100)
-	m.HashStringInput.S = make(chan string, 100)
-	m.HashStringOutput.Hash = make(chan uint64, 100)
+	m.HashRangeCalled = make(chan bool, 100)
+	m.HashRangeInput.Version = make(chan uint64, 100)
+	m.HashRangeInput.S = make(chan string, 100)
+	m.HashRangeOutput.Low = make(chan uint64, 100)
+	m.HashRangeOutput.High = make(chan uint64, 100)
+	m.HashRangeOutput.Err = make(chan error, 100)
 	return m
 }
-func (m *mockHasher) HashString(s string) (hash uint64) {
-	m.HashStringCalled <- true
-	m.HashStringInput.S <- s
-	return <-m.HashStringOutput.Hash
+func (m *mockHasher) HashRange(version uint64, s string) (low, high uint64, err error) {
+	m.HashRangeCalled <- true
+	m.HashRangeInput.Version <- version
+	m.HashRangeInput.S <- s
+	return <-m.HashRangeOutput.Low, <-m.HashRangeOutput.High, <-m.HashRangeOutput.Err
 }
@@ -1,14 +1,13 @@
 package filesystem
 
 import (
-	"encoding/json"
 	"log"
 
-	"github.com/poy/petasos/router"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 )
 
 type Hasher interface {
-	HashString(s string) (hash uint64)
+	HashRange(version uint64, s string) (low, high uint64, err error)
 }
 
 type RouteFilter struct {
@@ -22,21 +21,25 @@ func NewRouteFilter(h Hasher) *RouteFilter {
 }
 
 func (f *RouteFilter) Filter(route string, files map[string][]string) {
-	hash := f.hasher.HashString(route)
-
 	for file, _ := range files {
-		if !f.inRange(file, hash) {
+		if !f.inRange(file, route) {
 			delete(files, file)
 		}
 	}
 }
 
-func (f *RouteFilter) inRange(file string, hash uint64) bool {
-	var rn router.RangeName
-	if err := json.Unmarshal([]byte(file), &rn); err != nil {
+func (f *RouteFilter) inRange(file, route string) bool {
+	rn, err := hashing.ParseRangeName(file)
+	if err != nil {
 		log.Printf("Error parsing file (%s) into RangeName: %s", file, err)
 		return false
 	}
 
-	return rn.Low <= hash && rn.High >= hash
+	low, high, err := f.hasher.HashRange(rn.HashVersion, route)
+	if err != nil {
+		log.Printf("Unable to hash route for file (%s): %s", file, err)
+		return false
+	}
+
+	return rn.Low <= high && rn.High >= low
 }
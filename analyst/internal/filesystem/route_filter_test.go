@@ -23,7 +23,6 @@ func TestRouteFilter(t *testing.T) {
 
 	o.BeforeEach(func(t *testing.T) TRF {
 		mockHasher := newMockHasher()
-		testhelpers.AlwaysReturn(mockHasher.HashStringOutput.Hash, 99)
 
 		return TRF{
 			T:          t,
@@ -32,20 +31,71 @@ func TestRouteFilter(t *testing.T) {
 		}
 	})
 
-	o.Spec("it prunes out unrelated routes", func(t TRF) {
-		files := map[string][]string{
-			"invalid":                 nil,
-			`{"low":0, "high":99}`:    nil,
-			`{"low":100, "high":199}`: nil,
-			`{"low":99, "high":199}`:  nil,
-			`{"low":0, "high":199}`:   nil,
-		}
+	o.Group("when the route has a single hash", func() {
+		o.BeforeEach(func(t TRF) TRF {
+			testhelpers.AlwaysReturn(t.mockHasher.HashRangeOutput, 99, 99, nil)
+			return t
+		})
+
+		o.Spec("it prunes out unrelated routes", func(t TRF) {
+			files := map[string][]string{
+				"invalid":                 nil,
+				`{"low":0, "high":99}`:    nil,
+				`{"low":100, "high":199}`: nil,
+				`{"low":99, "high":199}`:  nil,
+				`{"low":0, "high":199}`:   nil,
+			}
+
+			t.f.Filter("some-route", files)
+			Expect(t, files).To(HaveLen(3))
+			Expect(t, files).To(HaveKey(`{"low":0, "high":99}`))
+			Expect(t, files).To(HaveKey(`{"low":99, "high":199}`))
+			Expect(t, files).To(HaveKey(`{"low":0, "high":199}`))
+		})
+	})
+
+	o.Group("when the route is spread across a hash range", func() {
+		o.BeforeEach(func(t TRF) TRF {
+			testhelpers.AlwaysReturn(t.mockHasher.HashRangeOutput, 150, 250, nil)
+			return t
+		})
+
+		o.Spec("it keeps every overlapping route", func(t TRF) {
+			files := map[string][]string{
+				`{"low":0, "high":99}`:    nil,
+				`{"low":100, "high":199}`: nil,
+				`{"low":200, "high":299}`: nil,
+				`{"low":300, "high":399}`: nil,
+			}
+
+			t.f.Filter("some-route", files)
+			Expect(t, files).To(HaveLen(2))
+			Expect(t, files).To(HaveKey(`{"low":100, "high":199}`))
+			Expect(t, files).To(HaveKey(`{"low":200, "high":299}`))
+		})
+	})
+
+	o.Group("when the routes have different hash versions", func() {
+		o.BeforeEach(func(t TRF) TRF {
+			testhelpers.AlwaysReturn(t.mockHasher.HashRangeOutput, 99, 99, nil)
+			return t
+		})
+
+		o.Spec("it hashes the route with each file's version", func(t TRF) {
+			files := map[string][]string{
+				`{"low":0, "high":99}`:                   nil,
+				`{"low":0, "high":99, "hash_version":2}`: nil,
+			}
 
-		t.f.Filter("some-route", files)
-		Expect(t, files).To(HaveLen(3))
-		Expect(t, files).To(HaveKey(`{"low":0, "high":99}`))
-		Expect(t, files).To(HaveKey(`{"low":99, "high":199}`))
-		Expect(t, files).To(HaveKey(`{"low":0, "high":199}`))
+			t.f.Filter("some-route", files)
+			Expect(t, files).To(HaveLen(2))
+			versions := []uint64{
+				<-t.mockHasher.HashRangeInput.Version,
+				<-t.mockHasher.HashRangeInput.Version,
+			}
+			Expect(t, versions).To(Contain(uint64(1), uint64(2)))
+			Expect(t, t.mockHasher.HashRangeInput.S).To(Chain(Receive(), Equal("some-route")))
+		})
 	})
 
 }
@@ -1,32 +1,107 @@
 package network
 
 import (
-	"io"
-	"log"
+	"fmt"
+	"sync"
+	"time"
 
 	"golang.org/x/net/context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/keepalive"
 
 	"github.com/poy/loggrebutterfly/api/intra"
 )
 
+// defaultMaxConcurrency is the number of calls that can be in flight to
+// each peer.
+const defaultMaxConcurrency = 16
+
+// KeepaliveTime is how often a connection with calls in flight is pinged.
+// The intra server has to permit pings this often.
+const KeepaliveTime = 30 * time.Second
+
+// UnavailableError is returned by Execute when the peer could not be
+// reached. The call can be retried.
+type UnavailableError struct {
+	Addr string
+	Err  error
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("analyst %s is unavailable: %s", e.Addr, e.Err)
+}
+
+// Temporary reports that the call can be retried.
+func (e *UnavailableError) Temporary() bool {
+	return true
+}
+
+type Option func(*Network)
+
+// WithMaxConcurrency limits the number of calls in flight to each peer.
+// Defaults to 16.
+func WithMaxConcurrency(n int) Option {
+	return func(net *Network) {
+		net.maxConcurrency = n
+	}
+}
+
+// Network executes algorithms on the peer analysts. It keeps a connection
+// to each peer that is shared by every call. gRPC reconnects to a peer that
+// went away and keepalive pings detect a peer that stopped responding.
 type Network struct {
+	maxConcurrency int
+
+	mu    sync.Mutex
+	peers map[string]*peer
+}
+
+type peer struct {
+	conn   *grpc.ClientConn
+	client intra.AnalystClient
+	slots  chan struct{}
 }
 
-func New() *Network {
-	return &Network{}
+func New(opts ...Option) *Network {
+	n := &Network{
+		maxConcurrency: defaultMaxConcurrency,
+		peers:          make(map[string]*peer),
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n
 }
 
 func (n *Network) Execute(file, algName, nodeID string, ctx context.Context, meta []byte) (result map[string][]byte, err error) {
-	client, closer := setupClient(nodeID)
-	defer closer.Close()
+	p, err := n.peer(nodeID)
+	if err != nil {
+		return nil, &UnavailableError{Addr: nodeID, Err: err}
+	}
+
+	if p.conn.GetState() == connectivity.TransientFailure {
+		return nil, &UnavailableError{Addr: nodeID, Err: fmt.Errorf("connection is in %s", connectivity.TransientFailure)}
+	}
+
+	select {
+	case p.slots <- struct{}{}:
+		defer func() { <-p.slots }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 
-	resp, err := client.Execute(ctx, &intra.ExecuteInfo{
+	resp, err := p.client.Execute(ctx, &intra.ExecuteInfo{
 		File: file,
 		Alg:  algName,
 		Meta: meta,
 	})
+	if grpc.Code(err) == codes.Unavailable {
+		return nil, &UnavailableError{Addr: nodeID, Err: err}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -34,10 +109,31 @@ func (n *Network) Execute(file, algName, nodeID string, ctx context.Context, met
 	return resp.Result, nil
 }
 
-func setupClient(addr string) (intra.AnalystClient, io.Closer) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+func (n *Network) peer(addr string) (*peer, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if p, ok := n.peers[addr]; ok {
+		return p, nil
+	}
+
+	conn, err := grpc.Dial(addr,
+		grpc.WithInsecure(),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    KeepaliveTime,
+			Timeout: KeepaliveTime / 2,
+		}),
+	)
 	if err != nil {
-		log.Fatalf("Unable to connect to analyst: %s", err)
+		return nil, err
+	}
+
+	p := &peer{
+		conn:   conn,
+		client: intra.NewAnalystClient(conn),
+		slots:  make(chan struct{}, n.maxConcurrency),
 	}
-	return intra.NewAnalystClient(conn), conn
+	n.peers[addr] = p
+
+	return p, nil
 }
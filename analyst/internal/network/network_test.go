@@ -8,7 +8,9 @@ import (
 	"log"
 	"net"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -33,6 +35,7 @@ type TN struct {
 	n                 *network.Network
 	mockAnalystServer *mockAnalystServer
 	analystAddr       string
+	lis               *countingListener
 }
 
 func TestNetwork(t *testing.T) {
@@ -41,13 +44,14 @@ func TestNetwork(t *testing.T) {
 	defer o.Run(t)
 
 	o.BeforeEach(func(t *testing.T) TN {
-		addr, mockAnalystServer := startAnalystServer()
+		lis, mockAnalystServer := startAnalystServer()
 
 		return TN{
 			T:                 t,
-			n:                 network.New(),
+			n:                 network.New(network.WithMaxConcurrency(1)),
 			mockAnalystServer: mockAnalystServer,
-			analystAddr:       addr,
+			analystAddr:       lis.Addr().String(),
+			lis:               lis,
 		}
 	})
 
@@ -82,6 +86,62 @@ func TestNetwork(t *testing.T) {
 				})),
 			)
 		})
+
+		o.Spec("it reuses the connection to the peer", func(t TN) {
+			t.mockAnalystServer.ExecuteOutput.Ret0 <- &intra.ExecuteResponse{}
+
+			_, err := t.n.Execute("some-file", "some-alg", t.analystAddr, context.Background(), nil)
+			Expect(t, err == nil).To(BeTrue())
+			_, err = t.n.Execute("some-file", "some-alg", t.analystAddr, context.Background(), nil)
+			Expect(t, err == nil).To(BeTrue())
+
+			Expect(t, atomic.LoadInt64(&t.lis.accepted)).To(Equal(int64(1)))
+		})
+	})
+
+	o.Group("when the server does not respond", func() {
+		o.Spec("it limits the calls in flight to the peer", func(t TN) {
+			go t.n.Execute("some-file", "some-alg", t.analystAddr, context.Background(), nil)
+			Expect(t, t.mockAnalystServer.ExecuteCalled).To(ViaPolling(HaveLen(1)))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, err := t.n.Execute("some-file", "some-alg", t.analystAddr, ctx, nil)
+			Expect(t, err).To(Equal(context.DeadlineExceeded))
+			Expect(t, t.mockAnalystServer.ExecuteCalled).To(HaveLen(1))
+		})
+	})
+
+	o.Group("when the peer is not listening", func() {
+		o.Spec("it returns a retryable error", func(t TN) {
+			t.lis.Close()
+
+			_, err := t.n.Execute("some-file", "some-alg", t.analystAddr, context.Background(), nil)
+			uerr, ok := err.(*network.UnavailableError)
+			Expect(t, ok).To(BeTrue())
+			Expect(t, uerr.Temporary()).To(BeTrue())
+			Expect(t, uerr.Addr).To(Equal(t.analystAddr))
+		})
+
+		o.Spec("it reconnects once the peer is back", func(t TN) {
+			t.lis.Close()
+
+			_, err := t.n.Execute("some-file", "some-alg", t.analystAddr, context.Background(), nil)
+			_, ok := err.(*network.UnavailableError)
+			Expect(t, ok).To(BeTrue())
+
+			mockAnalystServer := restartAnalystServer(t.analystAddr)
+			close(mockAnalystServer.ExecuteOutput.Ret1)
+			mockAnalystServer.ExecuteOutput.Ret0 <- &intra.ExecuteResponse{}
+
+			Expect(t, func() error {
+				_, err := t.n.Execute("some-file", "some-alg", t.analystAddr, context.Background(), nil)
+				return err
+			}).To(ViaPollingMatcher{
+				Matcher:  BeNil(),
+				Duration: 5 * time.Second,
+			})
+		})
 	})
 
 	o.Group("when the server returns an error", func() {
@@ -98,15 +158,43 @@ func TestNetwork(t *testing.T) {
 	})
 }
 
-func startAnalystServer() (string, *mockAnalystServer) {
-	lis, err := net.Listen("tcp", "localhost:0")
+func startAnalystServer() (*countingListener, *mockAnalystServer) {
+	l, err := net.Listen("tcp", "localhost:0")
 	if err != nil {
 		panic(err)
 	}
+	lis := &countingListener{Listener: l}
 	s := grpc.NewServer()
 	mockAnalystServer := newMockAnalystServer()
 	intra.RegisterAnalystServer(s, mockAnalystServer)
 	go s.Serve(lis)
 
-	return lis.Addr().String(), mockAnalystServer
+	return lis, mockAnalystServer
+}
+
+func restartAnalystServer(addr string) *mockAnalystServer {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		panic(err)
+	}
+	s := grpc.NewServer()
+	mockAnalystServer := newMockAnalystServer()
+	intra.RegisterAnalystServer(s, mockAnalystServer)
+	go s.Serve(lis)
+
+	return mockAnalystServer
+}
+
+// countingListener counts the accepted connections.
+type countingListener struct {
+	net.Listener
+	accepted int64
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		atomic.AddInt64(&l.accepted, 1)
+	}
+	return conn, err
 }
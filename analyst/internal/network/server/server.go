@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"sort"
 	"strconv"
 
 	"golang.org/x/net/context"
 
+	"github.com/poy/loggrebutterfly/analyst/internal/failover"
 	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
 	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hlc"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -38,13 +41,19 @@ func (s *Server) Query(ctx context.Context, info *v1.QueryInfo) (resp *v1.QueryR
 		return nil, err
 	}
 
+	ctx, report := bestEffort(ctx, info)
 	result, err := s.calc.Calculate(info.GetFilter().GetSourceId(), "timerange", ctx, data)
 	if err != nil {
 		return nil, err
 	}
 
+	envelopes := flattenResults(result)
+	sortEnvelopes(info.GetOrder(), envelopes)
+
 	return &v1.QueryResponse{
-		Envelopes: flattenResults(result),
+		Envelopes: envelopes,
+		Partial:   report.Partial(),
+		Warnings:  warnings(report),
 	}, nil
 }
 
@@ -66,20 +75,49 @@ func (s *Server) Aggregate(ctx context.Context, info *v1.AggregateInfo) (resp *v
 		return nil, err
 	}
 
+	ctx, report := bestEffort(ctx, info.GetQuery())
 	result, err := s.calc.Calculate(info.GetQuery().GetFilter().GetSourceId(), "aggregation", ctx, data)
 	if err != nil {
 		return nil, err
 	}
 
 	return &v1.AggregateResponse{
-		Results: resultsToFloat(result),
+		Results:  resultsToFloat(result),
+		Partial:  report.Partial(),
+		Warnings: warnings(report),
 	}, nil
 }
 
+// bestEffort adds a failover report to the context of best effort
+// queries. Other queries fail when a file fails and have a nil report.
+func bestEffort(ctx context.Context, info *v1.QueryInfo) (context.Context, *failover.Report) {
+	if !info.GetBestEffort() {
+		return ctx, nil
+	}
+	return failover.NewReport(ctx)
+}
+
+func warnings(r *failover.Report) []*v1.Warning {
+	var warnings []*v1.Warning
+	for _, f := range r.Failures() {
+		warnings = append(warnings, &v1.Warning{
+			File:   f.File,
+			Reason: f.Reason,
+		})
+	}
+	return warnings
+}
+
 func flattenResults(m map[string][]byte) (results []*loggregator.Envelope) {
-	for _, v := range m {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
 		var e loggregator.Envelope
-		if err := proto.Unmarshal(v, &e); err != nil {
+		if err := proto.Unmarshal(m[k], &e); err != nil {
 			log.Printf("Failed to unmarshal envelope: %s", err)
 			continue
 		}
@@ -89,6 +127,23 @@ func flattenResults(m map[string][]byte) (results []*loggregator.Envelope) {
 	return results
 }
 
+// sortEnvelopes orders the envelopes so every reader sees the same order
+// for the same data.
+func sortEnvelopes(order v1.QueryOrder, envelopes []*loggregator.Envelope) {
+	if order == v1.QueryOrder_HLC {
+		hlc.Sort(envelopes)
+		return
+	}
+
+	sort.SliceStable(envelopes, func(i, j int) bool {
+		a, b := envelopes[i], envelopes[j]
+		if a.Timestamp != b.Timestamp {
+			return a.Timestamp < b.Timestamp
+		}
+		return hlc.Of(a).Less(hlc.Of(b))
+	})
+}
+
 func resultsToFloat(r map[string][]byte) map[int64]float64 {
 	m := make(map[int64]float64)
 	for k, v := range r {
@@ -10,10 +10,12 @@ import (
 	"math"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/poy/loggrebutterfly/analyst/internal/network/server"
 	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
 	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hlc"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -127,6 +129,35 @@ func TestServerQuery(t *testing.T) {
 		})
 	})
 
+	o.Group("when the results are stamped with a hybrid logical clock", func() {
+		o.BeforeEach(func(t TS) TS {
+			close(t.mockCalc.CalculateOutput.Err)
+			t.mockCalc.CalculateOutput.FinalResult <- map[string][]byte{
+				"a": marshalStamped("a", 2, hlc.Timestamp{Wall: 1, Node: "x"}),
+				"b": marshalStamped("b", 1, hlc.Timestamp{Wall: 3, Node: "x"}),
+				"c": marshalStamped("c", 1, hlc.Timestamp{Wall: 2, Node: "x"}),
+			}
+			return t
+		})
+
+		o.Spec("it orders by timestamp", func(t TS) {
+			resp, err := t.s.Query(context.Background(), &v1.QueryInfo{
+				Filter: &v1.AnalystFilter{SourceId: "id"},
+			})
+			Expect(t, err == nil).To(BeTrue())
+			Expect(t, sourceIDs(resp.Envelopes)).To(Equal([]string{"c", "b", "a"}))
+		})
+
+		o.Spec("it orders by the hybrid logical clock", func(t TS) {
+			resp, err := t.s.Query(context.Background(), &v1.QueryInfo{
+				Filter: &v1.AnalystFilter{SourceId: "id"},
+				Order:  v1.QueryOrder_HLC,
+			})
+			Expect(t, err == nil).To(BeTrue())
+			Expect(t, sourceIDs(resp.Envelopes)).To(Equal([]string{"a", "c", "b"}))
+		})
+	})
+
 	o.Group("when the calculator returns an error", func() {
 		o.BeforeEach(func(t TS) TS {
 			t.mockCalc.CalculateOutput.Err <- fmt.Errorf("some-error")
@@ -316,6 +347,26 @@ func marshalEnvelope(sourceId string) []byte {
 	return data
 }
 
+func marshalStamped(sourceId string, timestamp int64, t hlc.Timestamp) []byte {
+	clock := hlc.NewClock(t.Node, func() time.Time {
+		return time.Unix(0, t.Wall)
+	})
+	e := clock.Stamp(&loggregator.Envelope{SourceId: sourceId, Timestamp: timestamp})
+	data, err := proto.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func sourceIDs(envelopes []*loggregator.Envelope) []string {
+	var ids []string
+	for _, e := range envelopes {
+		ids = append(ids, e.SourceId)
+	}
+	return ids
+}
+
 func marshalFloat64(f float64) []byte {
 	bits := math.Float64bits(f)
 	bytes := make([]byte, 8)
@@ -6,17 +6,20 @@ import (
 	"net/http"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 
 	"github.com/poy/loggrebutterfly/analyst/internal/algorithms"
 	"github.com/poy/loggrebutterfly/analyst/internal/algorithms/mappers"
 	"github.com/poy/loggrebutterfly/analyst/internal/algorithms/reducers"
 	"github.com/poy/loggrebutterfly/analyst/internal/config"
+	"github.com/poy/loggrebutterfly/analyst/internal/failover"
 	"github.com/poy/loggrebutterfly/analyst/internal/filesystem"
 	"github.com/poy/loggrebutterfly/analyst/internal/network"
 	"github.com/poy/loggrebutterfly/analyst/internal/network/intra"
 	"github.com/poy/loggrebutterfly/analyst/internal/network/server"
 	apiintra "github.com/poy/loggrebutterfly/api/intra"
 	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 	"github.com/poy/mapreduce"
 	"github.com/poy/talaria/api/v1"
 )
@@ -31,12 +34,19 @@ func main() {
 	schedClient := setupTalariaSchedulerClient(conf.TalariaSchedulerAddr)
 
 	algFetcher := setupAlgorithmFetcher()
-	hasher := filesystem.NewHasher()
+	hasher := hashing.NewHashers(conf.HotSources)
 	filter := filesystem.NewRouteFilter(hasher)
-	fs := filesystem.New(filter, schedClient, nodeClient, conf.ToAnalyst)
-	network := network.New()
-
-	mr := mapreduce.New(fs, network, algFetcher)
+	fs := filesystem.New(filter, schedClient, nodeClient, conf.ToAnalyst,
+		filesystem.WithLocalURI(conf.TalariaNodeURI),
+		filesystem.WithLocalAddr(conf.TalariaNodeAddr),
+	)
+	network := network.New(network.WithMaxConcurrency(conf.IntraMaxConcurrency))
+	failover := failover.New(fs, network,
+		failover.WithTimeout(conf.ExecuteTimeout),
+		failover.WithHedgeDelay(conf.HedgeDelay),
+	)
+
+	mr := mapreduce.New(failover, failover, algFetcher)
 	exec := mapreduce.NewExecutor(algFetcher, fs)
 
 	go startIntraServer(intra.New(exec), conf.IntraAddr)
@@ -54,7 +64,7 @@ func setupAlgorithmFetcher() *algorithms.Fetcher {
 				return mapreduce.Algorithm{}, err
 			}
 			return mapreduce.Algorithm{
-				Mapper:  mappers.NewQuery(filter),
+				Mapper:  mappers.NewQuery(info, filter),
 				Reducer: reducers.NewFirst(),
 			}, nil
 		}),
@@ -99,7 +109,9 @@ func startIntraServer(server *intra.Server, addr string) {
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime: network.KeepaliveTime,
+	}))
 	apiintra.RegisterAnalystServer(s, server)
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve intra: %s", err)
@@ -14,6 +14,17 @@ It has these top-level messages:
 	ExecuteResponse
 	ReadMetricsInfo
 	ReadMetricsResponse
+	ReadSequenceStatsInfo
+	ReadSequenceStatsResponse
+	SequenceStats
+	ReadDeadLettersInfo
+	ReadDeadLettersResponse
+	ReplayDeadLettersInfo
+	ReplayDeadLettersResponse
+	DeadLetter
+	ReadSkewStatsInfo
+	ReadSkewStatsResponse
+	SkewStats
 */
 package intra
 
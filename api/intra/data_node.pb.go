@@ -37,6 +37,12 @@ func (m *ReadMetricsInfo) GetFile() string {
 type ReadMetricsResponse struct {
 	WriteCount uint64 `protobuf:"varint,1,opt,name=writeCount" json:"writeCount,omitempty"`
 	ErrCount   uint64 `protobuf:"varint,2,opt,name=errCount" json:"errCount,omitempty"`
+	// duplicateCount is the number of envelopes that were dropped because
+	// their idempotency key was already written to the range.
+	DuplicateCount uint64 `protobuf:"varint,3,opt,name=duplicateCount" json:"duplicateCount,omitempty"`
+	// throttledCount is the number of envelopes that were dropped because
+	// their source was over its rate limit.
+	ThrottledCount uint64 `protobuf:"varint,4,opt,name=throttledCount" json:"throttledCount,omitempty"`
 }
 
 func (m *ReadMetricsResponse) Reset()                    { *m = ReadMetricsResponse{} }
@@ -58,9 +64,342 @@ func (m *ReadMetricsResponse) GetErrCount() uint64 {
 	return 0
 }
 
+func (m *ReadMetricsResponse) GetDuplicateCount() uint64 {
+	if m != nil {
+		return m.DuplicateCount
+	}
+	return 0
+}
+
+func (m *ReadMetricsResponse) GetThrottledCount() uint64 {
+	if m != nil {
+		return m.ThrottledCount
+	}
+	return 0
+}
+
+type ReadSequenceStatsInfo struct {
+	// sourceId limits the stats to a single source. Every source is
+	// returned when it is empty.
+	SourceId string `protobuf:"bytes,1,opt,name=sourceId" json:"sourceId,omitempty"`
+}
+
+func (m *ReadSequenceStatsInfo) Reset()                    { *m = ReadSequenceStatsInfo{} }
+func (m *ReadSequenceStatsInfo) String() string            { return proto.CompactTextString(m) }
+func (*ReadSequenceStatsInfo) ProtoMessage()               {}
+func (*ReadSequenceStatsInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
+
+func (m *ReadSequenceStatsInfo) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+type ReadSequenceStatsResponse struct {
+	Stats []*SequenceStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *ReadSequenceStatsResponse) Reset()                    { *m = ReadSequenceStatsResponse{} }
+func (m *ReadSequenceStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadSequenceStatsResponse) ProtoMessage()               {}
+func (*ReadSequenceStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
+
+func (m *ReadSequenceStatsResponse) GetStats() []*SequenceStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type SequenceStats struct {
+	SourceId     string `protobuf:"bytes,1,opt,name=sourceId" json:"sourceId,omitempty"`
+	Epoch        int64  `protobuf:"varint,2,opt,name=epoch" json:"epoch,omitempty"`
+	LastSequence uint64 `protobuf:"varint,3,opt,name=lastSequence" json:"lastSequence,omitempty"`
+	Gaps         uint64 `protobuf:"varint,4,opt,name=gaps" json:"gaps,omitempty"`
+	Reorders     uint64 `protobuf:"varint,5,opt,name=reorders" json:"reorders,omitempty"`
+	// stream is the secondary routing key of a hot source. Every stream has
+	// its own sequence. It is empty for other sources.
+	Stream string `protobuf:"bytes,6,opt,name=stream" json:"stream,omitempty"`
+}
+
+func (m *SequenceStats) Reset()                    { *m = SequenceStats{} }
+func (m *SequenceStats) String() string            { return proto.CompactTextString(m) }
+func (*SequenceStats) ProtoMessage()               {}
+func (*SequenceStats) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+
+func (m *SequenceStats) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+func (m *SequenceStats) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SequenceStats) GetLastSequence() uint64 {
+	if m != nil {
+		return m.LastSequence
+	}
+	return 0
+}
+
+func (m *SequenceStats) GetGaps() uint64 {
+	if m != nil {
+		return m.Gaps
+	}
+	return 0
+}
+
+func (m *SequenceStats) GetReorders() uint64 {
+	if m != nil {
+		return m.Reorders
+	}
+	return 0
+}
+
+func (m *SequenceStats) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+type ReadDeadLettersInfo struct {
+	StartId uint64 `protobuf:"varint,1,opt,name=startId" json:"startId,omitempty"`
+	// limit is the maximum number of letters returned. Every letter is
+	// returned when it is 0.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ReadDeadLettersInfo) Reset()                    { *m = ReadDeadLettersInfo{} }
+func (m *ReadDeadLettersInfo) String() string            { return proto.CompactTextString(m) }
+func (*ReadDeadLettersInfo) ProtoMessage()               {}
+func (*ReadDeadLettersInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+
+func (m *ReadDeadLettersInfo) GetStartId() uint64 {
+	if m != nil {
+		return m.StartId
+	}
+	return 0
+}
+
+func (m *ReadDeadLettersInfo) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ReadDeadLettersResponse struct {
+	Letters []*DeadLetter `protobuf:"bytes,1,rep,name=letters" json:"letters,omitempty"`
+}
+
+func (m *ReadDeadLettersResponse) Reset()                    { *m = ReadDeadLettersResponse{} }
+func (m *ReadDeadLettersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadDeadLettersResponse) ProtoMessage()               {}
+func (*ReadDeadLettersResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+
+func (m *ReadDeadLettersResponse) GetLetters() []*DeadLetter {
+	if m != nil {
+		return m.Letters
+	}
+	return nil
+}
+
+type ReplayDeadLettersInfo struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+}
+
+func (m *ReplayDeadLettersInfo) Reset()                    { *m = ReplayDeadLettersInfo{} }
+func (m *ReplayDeadLettersInfo) String() string            { return proto.CompactTextString(m) }
+func (*ReplayDeadLettersInfo) ProtoMessage()               {}
+func (*ReplayDeadLettersInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+
+func (m *ReplayDeadLettersInfo) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type ReplayDeadLettersResponse struct {
+	Replayed uint64 `protobuf:"varint,1,opt,name=replayed" json:"replayed,omitempty"`
+	// failed are the letters that were rejected again. They stay in the
+	// dead-letter buffer with the new reason.
+	Failed []*DeadLetter `protobuf:"bytes,2,rep,name=failed" json:"failed,omitempty"`
+}
+
+func (m *ReplayDeadLettersResponse) Reset()                    { *m = ReplayDeadLettersResponse{} }
+func (m *ReplayDeadLettersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplayDeadLettersResponse) ProtoMessage()               {}
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+
+func (m *ReplayDeadLettersResponse) GetReplayed() uint64 {
+	if m != nil {
+		return m.Replayed
+	}
+	return 0
+}
+
+func (m *ReplayDeadLettersResponse) GetFailed() []*DeadLetter {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+type DeadLetter struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *DeadLetter) Reset()                    { *m = DeadLetter{} }
+func (m *DeadLetter) String() string            { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()               {}
+func (*DeadLetter) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+
+func (m *DeadLetter) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ReadSkewStatsInfo struct {
+	// sourceId limits the stats to a single source. Every source is
+	// returned when it is empty.
+	SourceId string `protobuf:"bytes,1,opt,name=sourceId" json:"sourceId,omitempty"`
+}
+
+func (m *ReadSkewStatsInfo) Reset()                    { *m = ReadSkewStatsInfo{} }
+func (m *ReadSkewStatsInfo) String() string            { return proto.CompactTextString(m) }
+func (*ReadSkewStatsInfo) ProtoMessage()               {}
+func (*ReadSkewStatsInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+
+func (m *ReadSkewStatsInfo) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+type ReadSkewStatsResponse struct {
+	Stats []*SkewStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *ReadSkewStatsResponse) Reset()                    { *m = ReadSkewStatsResponse{} }
+func (m *ReadSkewStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadSkewStatsResponse) ProtoMessage()               {}
+func (*ReadSkewStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+
+func (m *ReadSkewStatsResponse) GetStats() []*SkewStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// SkewStats are in nanoseconds. The skew is the producer's timestamp minus
+// the ingest timestamp.
+type SkewStats struct {
+	SourceId  string `protobuf:"bytes,1,opt,name=sourceId" json:"sourceId,omitempty"`
+	Count     uint64 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Skewed    uint64 `protobuf:"varint,3,opt,name=skewed" json:"skewed,omitempty"`
+	MaxAhead  int64  `protobuf:"varint,4,opt,name=maxAhead" json:"maxAhead,omitempty"`
+	MaxBehind int64  `protobuf:"varint,5,opt,name=maxBehind" json:"maxBehind,omitempty"`
+	Last      int64  `protobuf:"varint,6,opt,name=last" json:"last,omitempty"`
+}
+
+func (m *SkewStats) Reset()                    { *m = SkewStats{} }
+func (m *SkewStats) String() string            { return proto.CompactTextString(m) }
+func (*SkewStats) ProtoMessage()               {}
+func (*SkewStats) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+
+func (m *SkewStats) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+func (m *SkewStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SkewStats) GetSkewed() uint64 {
+	if m != nil {
+		return m.Skewed
+	}
+	return 0
+}
+
+func (m *SkewStats) GetMaxAhead() int64 {
+	if m != nil {
+		return m.MaxAhead
+	}
+	return 0
+}
+
+func (m *SkewStats) GetMaxBehind() int64 {
+	if m != nil {
+		return m.MaxBehind
+	}
+	return 0
+}
+
+func (m *SkewStats) GetLast() int64 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ReadMetricsInfo)(nil), "intra.ReadMetricsInfo")
 	proto.RegisterType((*ReadMetricsResponse)(nil), "intra.ReadMetricsResponse")
+	proto.RegisterType((*ReadSequenceStatsInfo)(nil), "intra.ReadSequenceStatsInfo")
+	proto.RegisterType((*ReadSequenceStatsResponse)(nil), "intra.ReadSequenceStatsResponse")
+	proto.RegisterType((*SequenceStats)(nil), "intra.SequenceStats")
+	proto.RegisterType((*ReadDeadLettersInfo)(nil), "intra.ReadDeadLettersInfo")
+	proto.RegisterType((*ReadDeadLettersResponse)(nil), "intra.ReadDeadLettersResponse")
+	proto.RegisterType((*ReplayDeadLettersInfo)(nil), "intra.ReplayDeadLettersInfo")
+	proto.RegisterType((*ReplayDeadLettersResponse)(nil), "intra.ReplayDeadLettersResponse")
+	proto.RegisterType((*DeadLetter)(nil), "intra.DeadLetter")
+	proto.RegisterType((*ReadSkewStatsInfo)(nil), "intra.ReadSkewStatsInfo")
+	proto.RegisterType((*ReadSkewStatsResponse)(nil), "intra.ReadSkewStatsResponse")
+	proto.RegisterType((*SkewStats)(nil), "intra.SkewStats")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -75,6 +414,10 @@ const _ = grpc.SupportPackageIsVersion4
 
 type DataNodeClient interface {
 	ReadMetrics(ctx context.Context, in *ReadMetricsInfo, opts ...grpc.CallOption) (*ReadMetricsResponse, error)
+	ReadSequenceStats(ctx context.Context, in *ReadSequenceStatsInfo, opts ...grpc.CallOption) (*ReadSequenceStatsResponse, error)
+	ReadDeadLetters(ctx context.Context, in *ReadDeadLettersInfo, opts ...grpc.CallOption) (*ReadDeadLettersResponse, error)
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersInfo, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
+	ReadSkewStats(ctx context.Context, in *ReadSkewStatsInfo, opts ...grpc.CallOption) (*ReadSkewStatsResponse, error)
 }
 
 type dataNodeClient struct {
@@ -94,10 +437,50 @@ func (c *dataNodeClient) ReadMetrics(ctx context.Context, in *ReadMetricsInfo, o
 	return out, nil
 }
 
+func (c *dataNodeClient) ReadSequenceStats(ctx context.Context, in *ReadSequenceStatsInfo, opts ...grpc.CallOption) (*ReadSequenceStatsResponse, error) {
+	out := new(ReadSequenceStatsResponse)
+	err := grpc.Invoke(ctx, "/intra.DataNode/ReadSequenceStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) ReadDeadLetters(ctx context.Context, in *ReadDeadLettersInfo, opts ...grpc.CallOption) (*ReadDeadLettersResponse, error) {
+	out := new(ReadDeadLettersResponse)
+	err := grpc.Invoke(ctx, "/intra.DataNode/ReadDeadLetters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersInfo, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	out := new(ReplayDeadLettersResponse)
+	err := grpc.Invoke(ctx, "/intra.DataNode/ReplayDeadLetters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) ReadSkewStats(ctx context.Context, in *ReadSkewStatsInfo, opts ...grpc.CallOption) (*ReadSkewStatsResponse, error) {
+	out := new(ReadSkewStatsResponse)
+	err := grpc.Invoke(ctx, "/intra.DataNode/ReadSkewStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DataNode service
 
 type DataNodeServer interface {
 	ReadMetrics(context.Context, *ReadMetricsInfo) (*ReadMetricsResponse, error)
+	ReadSequenceStats(context.Context, *ReadSequenceStatsInfo) (*ReadSequenceStatsResponse, error)
+	ReadDeadLetters(context.Context, *ReadDeadLettersInfo) (*ReadDeadLettersResponse, error)
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersInfo) (*ReplayDeadLettersResponse, error)
+	ReadSkewStats(context.Context, *ReadSkewStatsInfo) (*ReadSkewStatsResponse, error)
 }
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
@@ -122,6 +505,78 @@ func _DataNode_ReadMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_ReadSequenceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSequenceStatsInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).ReadSequenceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intra.DataNode/ReadSequenceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).ReadSequenceStats(ctx, req.(*ReadSequenceStatsInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_ReadDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeadLettersInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).ReadDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intra.DataNode/ReadDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).ReadDeadLetters(ctx, req.(*ReadDeadLettersInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intra.DataNode/ReplayDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_ReadSkewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSkewStatsInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).ReadSkewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intra.DataNode/ReadSkewStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).ReadSkewStats(ctx, req.(*ReadSkewStatsInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "intra.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -130,6 +585,22 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadMetrics",
 			Handler:    _DataNode_ReadMetrics_Handler,
 		},
+		{
+			MethodName: "ReadSequenceStats",
+			Handler:    _DataNode_ReadSequenceStats_Handler,
+		},
+		{
+			MethodName: "ReadDeadLetters",
+			Handler:    _DataNode_ReadDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _DataNode_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReadSkewStats",
+			Handler:    _DataNode_ReadSkewStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_node.proto",
@@ -138,16 +609,45 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("data_node.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x49, 0x2c, 0x49,
-	0x8c, 0xcf, 0xcb, 0x4f, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcd, 0xcc, 0x2b,
-	0x29, 0x4a, 0x54, 0x52, 0xe5, 0xe2, 0x0f, 0x4a, 0x4d, 0x4c, 0xf1, 0x4d, 0x2d, 0x29, 0xca, 0x4c,
-	0x2e, 0xf6, 0xcc, 0x4b, 0xcb, 0x17, 0x12, 0xe2, 0x62, 0x49, 0xcb, 0xcc, 0x49, 0x95, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x0c, 0x02, 0xb3, 0x95, 0x02, 0xb9, 0x84, 0x91, 0x94, 0x05, 0xa5, 0x16, 0x17,
-	0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0xc9, 0x71, 0x71, 0x95, 0x17, 0x65, 0x96, 0xa4, 0x3a, 0xe7, 0x97,
-	0xe6, 0x95, 0x80, 0x35, 0xb0, 0x04, 0x21, 0x89, 0x08, 0x49, 0x71, 0x71, 0xa4, 0x16, 0x15, 0x41,
-	0x64, 0x99, 0xc0, 0xb2, 0x70, 0xbe, 0x91, 0x3f, 0x17, 0x87, 0x4b, 0x62, 0x49, 0xa2, 0x5f, 0x7e,
-	0x4a, 0xaa, 0x90, 0x33, 0x17, 0x37, 0x92, 0xf1, 0x42, 0x62, 0x7a, 0x60, 0xc7, 0xe9, 0xa1, 0xb9,
-	0x4c, 0x4a, 0x0a, 0x53, 0x1c, 0xe6, 0x14, 0x25, 0x86, 0x24, 0x36, 0xb0, 0xc7, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x35, 0x50, 0x7b, 0x42, 0xeb, 0x00, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x4f, 0xdc, 0x3a,
+	0x10, 0xbd, 0xbb, 0xd9, 0x5d, 0xd8, 0xe1, 0xdb, 0x17, 0xb8, 0x21, 0x42, 0x68, 0x65, 0xe9, 0x22,
+	0xb8, 0x57, 0xa2, 0x12, 0xfc, 0x80, 0xaa, 0x85, 0xb6, 0x42, 0xea, 0x87, 0x64, 0xd4, 0xe7, 0xca,
+	0xac, 0x87, 0xae, 0x45, 0x36, 0x4e, 0x1d, 0xaf, 0x80, 0x9f, 0xd2, 0xc7, 0xaa, 0xcf, 0xfd, 0x8f,
+	0x95, 0x3f, 0x92, 0x4d, 0xb2, 0xd0, 0xf6, 0xcd, 0x67, 0xe6, 0x78, 0x32, 0x73, 0x7c, 0xec, 0xc0,
+	0x86, 0xe0, 0x86, 0x7f, 0xca, 0x94, 0xc0, 0x93, 0x5c, 0x2b, 0xa3, 0x48, 0x5f, 0x66, 0x46, 0x73,
+	0xfa, 0x2f, 0x6c, 0x30, 0xe4, 0xe2, 0x1d, 0x1a, 0x2d, 0xc7, 0xc5, 0x65, 0x76, 0xa3, 0x08, 0x81,
+	0xde, 0x8d, 0x4c, 0x31, 0xee, 0x8c, 0x3a, 0x47, 0x43, 0xe6, 0xd6, 0xf4, 0x5b, 0x07, 0xfe, 0xae,
+	0xf1, 0x18, 0x16, 0xb9, 0xca, 0x0a, 0x24, 0x07, 0x00, 0x77, 0x5a, 0x1a, 0x3c, 0x57, 0xb3, 0xcc,
+	0xb8, 0x1d, 0x3d, 0x56, 0x8b, 0x90, 0x04, 0x96, 0x51, 0x6b, 0x9f, 0xed, 0xba, 0x6c, 0x85, 0xc9,
+	0x21, 0xac, 0x8b, 0x59, 0x9e, 0xca, 0x31, 0x2f, 0xf7, 0x47, 0x8e, 0xd1, 0x8a, 0x5a, 0x9e, 0x99,
+	0x68, 0x65, 0x4c, 0x8a, 0xc2, 0xf3, 0x7a, 0x9e, 0xd7, 0x8c, 0xd2, 0x33, 0xd8, 0xb1, 0x2d, 0x5e,
+	0xe1, 0x97, 0x19, 0x66, 0x63, 0xbc, 0x32, 0xdc, 0xf8, 0x81, 0x12, 0x58, 0x2e, 0xd4, 0x4c, 0x8f,
+	0xf1, 0x52, 0x84, 0xa1, 0x2a, 0x4c, 0xdf, 0xc0, 0xde, 0xc2, 0xa6, 0x6a, 0xba, 0xff, 0xa0, 0x5f,
+	0xd8, 0x40, 0xdc, 0x19, 0x45, 0x47, 0x2b, 0xa7, 0xdb, 0x27, 0x4e, 0xb3, 0x93, 0x26, 0xd9, 0x53,
+	0xe8, 0x8f, 0x0e, 0xac, 0x35, 0x12, 0xbf, 0xfa, 0x2c, 0xd9, 0x86, 0x3e, 0xe6, 0x6a, 0x3c, 0x71,
+	0xa2, 0x44, 0xcc, 0x03, 0x42, 0x61, 0x35, 0xe5, 0x85, 0x29, 0xcb, 0x04, 0x3d, 0x1a, 0x31, 0x7b,
+	0x3a, 0x9f, 0x79, 0x5e, 0x04, 0x0d, 0xdc, 0xda, 0x7e, 0x49, 0xa3, 0xd2, 0x02, 0x75, 0x11, 0xf7,
+	0xbd, 0xca, 0x25, 0x26, 0xbb, 0x30, 0x28, 0x8c, 0x46, 0x3e, 0x8d, 0x07, 0xae, 0x87, 0x80, 0xe8,
+	0x2b, 0x7f, 0xa0, 0x17, 0xc8, 0xc5, 0x5b, 0x34, 0x06, 0xb5, 0xd7, 0x2a, 0x86, 0xa5, 0xc2, 0x70,
+	0x6d, 0x42, 0xcf, 0x3d, 0x56, 0x42, 0xdb, 0x72, 0x2a, 0xa7, 0xb2, 0x3c, 0x47, 0x0f, 0xe8, 0x6b,
+	0xf8, 0xa7, 0x55, 0xa6, 0x52, 0xef, 0x7f, 0x58, 0x4a, 0x7d, 0x28, 0xe8, 0xb7, 0x15, 0xf4, 0x9b,
+	0x93, 0x59, 0xc9, 0xa0, 0xc7, 0xf6, 0xf0, 0xf2, 0x94, 0x3f, 0xb4, 0x1b, 0xda, 0x84, 0x48, 0x0a,
+	0x5f, 0xa1, 0xc7, 0xec, 0x92, 0x5e, 0xc3, 0xde, 0x02, 0xb5, 0xfa, 0xa8, 0x93, 0xc2, 0x26, 0xb1,
+	0x1c, 0xa0, 0xc2, 0xe4, 0x18, 0x06, 0x37, 0x5c, 0xa6, 0x28, 0xe2, 0xee, 0x53, 0xfd, 0x04, 0x02,
+	0x4d, 0x01, 0xe6, 0x51, 0xb2, 0x0e, 0x5d, 0x59, 0x96, 0xeb, 0x4a, 0x61, 0x45, 0xca, 0xf9, 0x43,
+	0xaa, 0xb8, 0x70, 0x62, 0xac, 0xb2, 0x12, 0x5a, 0xb5, 0x35, 0xf2, 0x42, 0x65, 0xee, 0xec, 0x86,
+	0x2c, 0x20, 0xb2, 0x0f, 0x43, 0x23, 0xa7, 0x58, 0x18, 0x3e, 0xcd, 0xdd, 0xd1, 0x45, 0x6c, 0x1e,
+	0xa0, 0xcf, 0x60, 0xcb, 0x99, 0xf0, 0x16, 0xef, 0xfe, 0xcc, 0xb5, 0xcf, 0x61, 0xa7, 0xb1, 0xa1,
+	0x1a, 0xff, 0xb0, 0xe9, 0xd8, 0xcd, 0xd2, 0xb1, 0x15, 0x31, 0xb8, 0xf5, 0x7b, 0x07, 0x86, 0x55,
+	0xf0, 0x77, 0x4e, 0x1d, 0xd7, 0xae, 0xaf, 0x07, 0xce, 0x55, 0xb7, 0x78, 0x87, 0x22, 0x78, 0x34,
+	0x20, 0x5b, 0x69, 0xca, 0xef, 0x5f, 0x4c, 0x90, 0x8b, 0x30, 0x66, 0x85, 0xad, 0x06, 0x53, 0x7e,
+	0xff, 0x12, 0x27, 0x32, 0x13, 0xce, 0xa6, 0x11, 0x9b, 0x07, 0xac, 0xaf, 0xad, 0xcf, 0x9d, 0x4b,
+	0x23, 0xe6, 0xd6, 0xa7, 0x5f, 0x23, 0x58, 0xbe, 0xe0, 0x86, 0xbf, 0x57, 0x02, 0xc9, 0x39, 0xac,
+	0xd4, 0x5e, 0x20, 0xb2, 0x1b, 0x46, 0x6b, 0xbd, 0x5e, 0x49, 0xb2, 0x18, 0x2f, 0xd5, 0xa1, 0x7f,
+	0x91, 0x8f, 0x41, 0xe9, 0xc6, 0x45, 0xdd, 0xaf, 0x6d, 0x59, 0x78, 0x3d, 0x92, 0xd1, 0x53, 0xd9,
+	0x5a, 0xd9, 0x0f, 0xfe, 0x15, 0xad, 0x19, 0x92, 0xd4, 0xfb, 0x68, 0x79, 0x3a, 0x39, 0x78, 0x3c,
+	0xd7, 0xee, 0xb3, 0xe5, 0xf1, 0x5a, 0x9f, 0x8f, 0x5c, 0x94, 0x64, 0xf4, 0x54, 0xb6, 0x56, 0xf6,
+	0x12, 0xd6, 0x1a, 0xbe, 0x21, 0x71, 0x7d, 0xb8, 0xba, 0xfd, 0x92, 0xfd, 0xc7, 0x32, 0xf3, 0x52,
+	0xd7, 0x03, 0xf7, 0x1b, 0x39, 0xfb, 0x39, 0x00, 0xfc, 0x74, 0x1e, 0x7a, 0x59, 0x06, 0x00, 0x00,
 }
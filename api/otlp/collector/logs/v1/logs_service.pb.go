@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go.
+// source: collector/logs/v1/logs_service.proto
+// DO NOT EDIT!
+
+/*
+Package opentelemetry_proto_collector_logs_v1 is a generated protocol buffer package.
+
+It is generated from these files:
+	collector/logs/v1/logs_service.proto
+
+It has these top-level messages:
+	ExportLogsServiceRequest
+	ExportLogsServiceResponse
+	ExportLogsPartialSuccess
+*/
+package opentelemetry_proto_collector_logs_v1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import opentelemetry_proto_logs_v1 "github.com/poy/loggrebutterfly/api/otlp/logs/v1"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ExportLogsServiceRequest struct {
+	ResourceLogs []*opentelemetry_proto_logs_v1.ResourceLogs `protobuf:"bytes,1,rep,name=resource_logs,json=resourceLogs" json:"resource_logs,omitempty"`
+}
+
+func (m *ExportLogsServiceRequest) Reset()                    { *m = ExportLogsServiceRequest{} }
+func (m *ExportLogsServiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportLogsServiceRequest) ProtoMessage()               {}
+func (*ExportLogsServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *ExportLogsServiceRequest) GetResourceLogs() []*opentelemetry_proto_logs_v1.ResourceLogs {
+	if m != nil {
+		return m.ResourceLogs
+	}
+	return nil
+}
+
+type ExportLogsServiceResponse struct {
+	PartialSuccess *ExportLogsPartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess" json:"partial_success,omitempty"`
+}
+
+func (m *ExportLogsServiceResponse) Reset()                    { *m = ExportLogsServiceResponse{} }
+func (m *ExportLogsServiceResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportLogsServiceResponse) ProtoMessage()               {}
+func (*ExportLogsServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ExportLogsServiceResponse) GetPartialSuccess() *ExportLogsPartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return nil
+}
+
+type ExportLogsPartialSuccess struct {
+	RejectedLogRecords int64  `protobuf:"varint,1,opt,name=rejected_log_records,json=rejectedLogRecords" json:"rejected_log_records,omitempty"`
+	ErrorMessage       string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+}
+
+func (m *ExportLogsPartialSuccess) Reset()                    { *m = ExportLogsPartialSuccess{} }
+func (m *ExportLogsPartialSuccess) String() string            { return proto.CompactTextString(m) }
+func (*ExportLogsPartialSuccess) ProtoMessage()               {}
+func (*ExportLogsPartialSuccess) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ExportLogsPartialSuccess) GetRejectedLogRecords() int64 {
+	if m != nil {
+		return m.RejectedLogRecords
+	}
+	return 0
+}
+
+func (m *ExportLogsPartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExportLogsServiceRequest)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest")
+	proto.RegisterType((*ExportLogsServiceResponse)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsServiceResponse")
+	proto.RegisterType((*ExportLogsPartialSuccess)(nil), "opentelemetry.proto.collector.logs.v1.ExportLogsPartialSuccess")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for LogsService service
+
+type LogsServiceClient interface {
+	Export(ctx context.Context, in *ExportLogsServiceRequest, opts ...grpc.CallOption) (*ExportLogsServiceResponse, error)
+}
+
+type logsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLogsServiceClient(cc *grpc.ClientConn) LogsServiceClient {
+	return &logsServiceClient{cc}
+}
+
+func (c *logsServiceClient) Export(ctx context.Context, in *ExportLogsServiceRequest, opts ...grpc.CallOption) (*ExportLogsServiceResponse, error) {
+	out := new(ExportLogsServiceResponse)
+	err := grpc.Invoke(ctx, "/opentelemetry.proto.collector.logs.v1.LogsService/Export", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for LogsService service
+
+type LogsServiceServer interface {
+	Export(context.Context, *ExportLogsServiceRequest) (*ExportLogsServiceResponse, error)
+}
+
+func RegisterLogsServiceServer(s *grpc.Server, srv LogsServiceServer) {
+	s.RegisterService(&_LogsService_serviceDesc, srv)
+}
+
+func _LogsService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLogsServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opentelemetry.proto.collector.logs.v1.LogsService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServiceServer).Export(ctx, req.(*ExportLogsServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LogsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "opentelemetry.proto.collector.logs.v1.LogsService",
+	HandlerType: (*LogsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    _LogsService_Export_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "collector/logs/v1/logs_service.proto",
+}
+
+func init() { proto.RegisterFile("collector/logs/v1/logs_service.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4e, 0xfa, 0x40,
+	0x10, 0xc6, 0xff, 0xfd, 0x93, 0x90, 0xb8, 0x80, 0x26, 0x1b, 0x0f, 0x95, 0x13, 0xa9, 0x9a, 0xe0,
+	0x65, 0x2b, 0x78, 0xf4, 0xa0, 0x31, 0xf1, 0x86, 0xc6, 0x94, 0x9b, 0x97, 0x4d, 0x59, 0xc6, 0x52,
+	0x52, 0x98, 0x65, 0x76, 0xdb, 0xc8, 0x03, 0x78, 0xf4, 0x11, 0x7c, 0x57, 0xd3, 0x2e, 0x22, 0x44,
+	0x4c, 0xd4, 0x53, 0xb3, 0x33, 0xf3, 0xfd, 0xbe, 0xfd, 0xba, 0xc3, 0x4e, 0x14, 0x66, 0x19, 0x28,
+	0x8b, 0x14, 0x66, 0x98, 0x98, 0xb0, 0xe8, 0x55, 0x5f, 0x69, 0x80, 0x8a, 0x54, 0x81, 0xd0, 0x84,
+	0x16, 0xf9, 0x29, 0x6a, 0x98, 0x5b, 0xc8, 0x60, 0x06, 0x96, 0x96, 0xae, 0x28, 0xd6, 0x4a, 0x51,
+	0x2a, 0x44, 0xd1, 0x6b, 0xf3, 0x4d, 0x84, 0x9b, 0x0a, 0xa6, 0xcc, 0xbf, 0x7d, 0xd6, 0x48, 0x76,
+	0x80, 0x89, 0x19, 0x3a, 0x6a, 0x04, 0x8b, 0x1c, 0x8c, 0xe5, 0xf7, 0xac, 0x45, 0x60, 0x30, 0x27,
+	0x05, 0xb2, 0x94, 0xf8, 0x5e, 0xa7, 0xd6, 0x6d, 0xf4, 0xcf, 0xc4, 0x2e, 0xbb, 0x95, 0x89, 0x88,
+	0x56, 0x8a, 0x92, 0x17, 0x35, 0x69, 0xe3, 0x14, 0xbc, 0x78, 0xec, 0x68, 0x87, 0x99, 0xd1, 0x38,
+	0x37, 0xc0, 0x27, 0xec, 0x40, 0xc7, 0x64, 0xd3, 0x38, 0x93, 0x26, 0x57, 0x0a, 0x4c, 0xe9, 0xe7,
+	0x75, 0x1b, 0xfd, 0x2b, 0xf1, 0xa3, 0x78, 0xe2, 0x13, 0xfd, 0xe0, 0x38, 0x43, 0x87, 0x89, 0xf6,
+	0xf5, 0xd6, 0x39, 0x58, 0x30, 0xff, 0xbb, 0x59, 0x7e, 0xce, 0x0e, 0x09, 0xa6, 0xa0, 0x2c, 0x8c,
+	0xcb, 0xcc, 0x92, 0x40, 0x21, 0x8d, 0xdd, 0x55, 0x6a, 0x11, 0xff, 0xe8, 0x0d, 0x30, 0x89, 0x5c,
+	0x87, 0x1f, 0xb3, 0x16, 0x10, 0x21, 0xc9, 0x19, 0x18, 0x13, 0x27, 0xe0, 0xff, 0xef, 0x78, 0xdd,
+	0xbd, 0xa8, 0x59, 0x15, 0xef, 0x5c, 0xad, 0xff, 0xe6, 0xb1, 0xc6, 0x46, 0x68, 0xfe, 0xea, 0xb1,
+	0xba, 0xbb, 0x03, 0xff, 0x7d, 0xbc, 0xed, 0x67, 0x6a, 0x5f, 0xff, 0x1d, 0xe0, 0x7e, 0x7d, 0xf0,
+	0xef, 0x26, 0x7e, 0x94, 0x49, 0x6a, 0x27, 0xf9, 0x48, 0x28, 0x9c, 0x85, 0x1a, 0x97, 0xe5, 0x8e,
+	0x24, 0x04, 0xa3, 0xdc, 0x5a, 0xa0, 0xa7, 0x6c, 0x19, 0xc6, 0x3a, 0x0d, 0xd1, 0x66, 0x3a, 0xfc,
+	0xb2, 0x91, 0x97, 0x5b, 0xf6, 0xb2, 0xb2, 0x97, 0xeb, 0xa9, 0x6a, 0x73, 0x64, 0xd1, 0x1b, 0xd5,
+	0xab, 0xc6, 0xc5, 0xfb, 0x00, 0xc4, 0xa3, 0xe7, 0xe6, 0xd3, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-go.
+// source: collector/metrics/v1/metrics_service.proto
+// DO NOT EDIT!
+
+/*
+Package opentelemetry_proto_collector_metrics_v1 is a generated protocol buffer package.
+
+It is generated from these files:
+	collector/metrics/v1/metrics_service.proto
+
+It has these top-level messages:
+	ExportMetricsServiceRequest
+	ExportMetricsServiceResponse
+	ExportMetricsPartialSuccess
+*/
+package opentelemetry_proto_collector_metrics_v1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import opentelemetry_proto_metrics_v1 "github.com/poy/loggrebutterfly/api/otlp/metrics/v1"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ExportMetricsServiceRequest struct {
+	ResourceMetrics []*opentelemetry_proto_metrics_v1.ResourceMetrics `protobuf:"bytes,1,rep,name=resource_metrics,json=resourceMetrics" json:"resource_metrics,omitempty"`
+}
+
+func (m *ExportMetricsServiceRequest) Reset()                    { *m = ExportMetricsServiceRequest{} }
+func (m *ExportMetricsServiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportMetricsServiceRequest) ProtoMessage()               {}
+func (*ExportMetricsServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *ExportMetricsServiceRequest) GetResourceMetrics() []*opentelemetry_proto_metrics_v1.ResourceMetrics {
+	if m != nil {
+		return m.ResourceMetrics
+	}
+	return nil
+}
+
+type ExportMetricsServiceResponse struct {
+	PartialSuccess *ExportMetricsPartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess" json:"partial_success,omitempty"`
+}
+
+func (m *ExportMetricsServiceResponse) Reset()                    { *m = ExportMetricsServiceResponse{} }
+func (m *ExportMetricsServiceResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportMetricsServiceResponse) ProtoMessage()               {}
+func (*ExportMetricsServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ExportMetricsServiceResponse) GetPartialSuccess() *ExportMetricsPartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return nil
+}
+
+type ExportMetricsPartialSuccess struct {
+	RejectedDataPoints int64  `protobuf:"varint,1,opt,name=rejected_data_points,json=rejectedDataPoints" json:"rejected_data_points,omitempty"`
+	ErrorMessage       string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+}
+
+func (m *ExportMetricsPartialSuccess) Reset()                    { *m = ExportMetricsPartialSuccess{} }
+func (m *ExportMetricsPartialSuccess) String() string            { return proto.CompactTextString(m) }
+func (*ExportMetricsPartialSuccess) ProtoMessage()               {}
+func (*ExportMetricsPartialSuccess) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ExportMetricsPartialSuccess) GetRejectedDataPoints() int64 {
+	if m != nil {
+		return m.RejectedDataPoints
+	}
+	return 0
+}
+
+func (m *ExportMetricsPartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExportMetricsServiceRequest)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceRequest")
+	proto.RegisterType((*ExportMetricsServiceResponse)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsServiceResponse")
+	proto.RegisterType((*ExportMetricsPartialSuccess)(nil), "opentelemetry.proto.collector.metrics.v1.ExportMetricsPartialSuccess")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for MetricsService service
+
+type MetricsServiceClient interface {
+	Export(ctx context.Context, in *ExportMetricsServiceRequest, opts ...grpc.CallOption) (*ExportMetricsServiceResponse, error)
+}
+
+type metricsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMetricsServiceClient(cc *grpc.ClientConn) MetricsServiceClient {
+	return &metricsServiceClient{cc}
+}
+
+func (c *metricsServiceClient) Export(ctx context.Context, in *ExportMetricsServiceRequest, opts ...grpc.CallOption) (*ExportMetricsServiceResponse, error) {
+	out := new(ExportMetricsServiceResponse)
+	err := grpc.Invoke(ctx, "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MetricsService service
+
+type MetricsServiceServer interface {
+	Export(context.Context, *ExportMetricsServiceRequest) (*ExportMetricsServiceResponse, error)
+}
+
+func RegisterMetricsServiceServer(s *grpc.Server, srv MetricsServiceServer) {
+	s.RegisterService(&_MetricsService_serviceDesc, srv)
+}
+
+func _MetricsService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMetricsServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).Export(ctx, req.(*ExportMetricsServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MetricsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "opentelemetry.proto.collector.metrics.v1.MetricsService",
+	HandlerType: (*MetricsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    _MetricsService_Export_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "collector/metrics/v1/metrics_service.proto",
+}
+
+func init() { proto.RegisterFile("collector/metrics/v1/metrics_service.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x52, 0x3f, 0x6f, 0xe2, 0x30,
+	0x14, 0xbf, 0x1c, 0x12, 0xd2, 0x99, 0x3b, 0x38, 0x59, 0x37, 0x20, 0xb8, 0x01, 0xe5, 0x96, 0xe8,
+	0x06, 0xbb, 0xd0, 0xb1, 0x5b, 0x55, 0xba, 0x21, 0xa1, 0xb0, 0xb1, 0x58, 0xc6, 0xbc, 0xa6, 0xa9,
+	0x42, 0xec, 0xda, 0x2f, 0x51, 0xf3, 0x25, 0xba, 0xf7, 0x3b, 0xf4, 0x43, 0x56, 0xc4, 0x81, 0x2a,
+	0x2a, 0xaa, 0xaa, 0x76, 0x4b, 0x7e, 0xbf, 0xf7, 0xfb, 0x63, 0xfb, 0x91, 0xff, 0x4a, 0x67, 0x19,
+	0x28, 0xd4, 0x96, 0xef, 0x00, 0x6d, 0xaa, 0x1c, 0x2f, 0xa7, 0x87, 0x4f, 0xe1, 0xc0, 0x96, 0xa9,
+	0x02, 0x66, 0xac, 0x46, 0x4d, 0x23, 0x6d, 0x20, 0x47, 0xc8, 0x60, 0x4f, 0x57, 0x1e, 0x64, 0x47,
+	0x3d, 0x6b, 0x44, 0xac, 0x9c, 0x8e, 0x86, 0x6f, 0xbd, 0xfc, 0x78, 0x58, 0x91, 0xf1, 0xfc, 0xc1,
+	0x68, 0x8b, 0x0b, 0x0f, 0xaf, 0x7c, 0x42, 0x0c, 0xf7, 0x05, 0x38, 0xa4, 0x6b, 0xf2, 0xdb, 0x82,
+	0xd3, 0x85, 0x55, 0x20, 0x1a, 0xe1, 0x30, 0x98, 0x74, 0xa2, 0xde, 0x8c, 0xb3, 0x53, 0xe9, 0xaf,
+	0x99, 0x2c, 0x6e, 0x74, 0x8d, 0x71, 0x3c, 0xb0, 0x6d, 0x20, 0x7c, 0x0c, 0xc8, 0xdf, 0xd3, 0xd9,
+	0xce, 0xe8, 0xdc, 0x01, 0xcd, 0xc9, 0xc0, 0x48, 0x8b, 0xa9, 0xcc, 0x84, 0x2b, 0x94, 0x02, 0xb7,
+	0xcf, 0x0e, 0xa2, 0xde, 0x6c, 0xce, 0x3e, 0x7a, 0x72, 0xd6, 0x0a, 0x58, 0x7a, 0xb7, 0x95, 0x37,
+	0x8b, 0xfb, 0xa6, 0xf5, 0x1f, 0x22, 0x19, 0xbf, 0x33, 0x4e, 0xcf, 0xc8, 0x1f, 0x0b, 0x77, 0xa0,
+	0x10, 0xb6, 0x62, 0x2b, 0x51, 0x0a, 0xa3, 0xd3, 0x1c, 0x7d, 0xa7, 0x4e, 0x4c, 0x0f, 0xdc, 0x95,
+	0x44, 0xb9, 0xac, 0x19, 0xfa, 0x8f, 0xfc, 0x02, 0x6b, 0xb5, 0x15, 0x3b, 0x70, 0x4e, 0x26, 0x30,
+	0xfc, 0x3e, 0x09, 0xa2, 0x1f, 0xf1, 0xcf, 0x1a, 0x5c, 0x78, 0x6c, 0xf6, 0x1c, 0x90, 0x7e, 0xfb,
+	0x02, 0xe8, 0x53, 0x40, 0xba, 0xbe, 0x09, 0xfd, 0xec, 0x51, 0xdb, 0xef, 0x38, 0xba, 0xfe, 0xaa,
+	0x8d, 0x7f, 0x92, 0xf0, 0xdb, 0x65, 0xb2, 0x86, 0x24, 0xc5, 0xdb, 0x62, 0xc3, 0x94, 0xde, 0x71,
+	0xa3, 0x2b, 0x9e, 0xe9, 0x24, 0xb1, 0xb0, 0x29, 0x10, 0xc1, 0xde, 0x64, 0x15, 0x97, 0x26, 0xe5,
+	0x1a, 0x33, 0xc3, 0x4f, 0xad, 0xf2, 0x45, 0xab, 0x87, 0xa8, 0x7b, 0x88, 0xe3, 0xe0, 0x61, 0xc7,
+	0x44, 0x39, 0xdd, 0x74, 0x6b, 0xee, 0xfc, 0x65, 0x00, 0x23, 0x0b, 0x43, 0x8d, 0x12, 0x03, 0x00,
+	0x00,
+}
@@ -0,0 +1,23 @@
+#!/bin/bash
+
+dir_resolve()
+{
+    cd "$1" 2>/dev/null || return $?  # cd to desired directory; if fail, quell any error messages but return exit status
+    echo "`pwd -P`" # output full, link-resolved path
+}
+
+set -e
+
+# The protos are a subset of opentelemetry-proto v1.0.0. Only the messages
+# needed to ingest logs, gauges and sums are kept. Field numbers match
+# upstream so the wire format is compatible.
+
+target=`dirname $0`
+target=`dir_resolve $target`
+cd $target
+
+go get github.com/golang/protobuf/{proto,protoc-gen-go}
+
+for dir in common/v1 resource/v1 logs/v1 metrics/v1 collector/logs/v1 collector/metrics/v1; do
+    protoc $dir/*.proto --go_out=plugins=grpc,paths=source_relative:. --proto_path=.
+done
@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go.
+// source: logs/v1/logs.proto
+// DO NOT EDIT!
+
+/*
+Package opentelemetry_proto_logs_v1 is a generated protocol buffer package.
+
+It is generated from these files:
+	logs/v1/logs.proto
+
+It has these top-level messages:
+	LogsData
+	ResourceLogs
+	ScopeLogs
+	LogRecord
+*/
+package opentelemetry_proto_logs_v1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import opentelemetry_proto_common_v1 "github.com/poy/loggrebutterfly/api/otlp/common/v1"
+import opentelemetry_proto_resource_v1 "github.com/poy/loggrebutterfly/api/otlp/resource/v1"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type SeverityNumber int32
+
+const (
+	SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED SeverityNumber = 0
+	SeverityNumber_SEVERITY_NUMBER_TRACE       SeverityNumber = 1
+	SeverityNumber_SEVERITY_NUMBER_TRACE2      SeverityNumber = 2
+	SeverityNumber_SEVERITY_NUMBER_TRACE3      SeverityNumber = 3
+	SeverityNumber_SEVERITY_NUMBER_TRACE4      SeverityNumber = 4
+	SeverityNumber_SEVERITY_NUMBER_DEBUG       SeverityNumber = 5
+	SeverityNumber_SEVERITY_NUMBER_DEBUG2      SeverityNumber = 6
+	SeverityNumber_SEVERITY_NUMBER_DEBUG3      SeverityNumber = 7
+	SeverityNumber_SEVERITY_NUMBER_DEBUG4      SeverityNumber = 8
+	SeverityNumber_SEVERITY_NUMBER_INFO        SeverityNumber = 9
+	SeverityNumber_SEVERITY_NUMBER_INFO2       SeverityNumber = 10
+	SeverityNumber_SEVERITY_NUMBER_INFO3       SeverityNumber = 11
+	SeverityNumber_SEVERITY_NUMBER_INFO4       SeverityNumber = 12
+	SeverityNumber_SEVERITY_NUMBER_WARN        SeverityNumber = 13
+	SeverityNumber_SEVERITY_NUMBER_WARN2       SeverityNumber = 14
+	SeverityNumber_SEVERITY_NUMBER_WARN3       SeverityNumber = 15
+	SeverityNumber_SEVERITY_NUMBER_WARN4       SeverityNumber = 16
+	SeverityNumber_SEVERITY_NUMBER_ERROR       SeverityNumber = 17
+	SeverityNumber_SEVERITY_NUMBER_ERROR2      SeverityNumber = 18
+	SeverityNumber_SEVERITY_NUMBER_ERROR3      SeverityNumber = 19
+	SeverityNumber_SEVERITY_NUMBER_ERROR4      SeverityNumber = 20
+	SeverityNumber_SEVERITY_NUMBER_FATAL       SeverityNumber = 21
+	SeverityNumber_SEVERITY_NUMBER_FATAL2      SeverityNumber = 22
+	SeverityNumber_SEVERITY_NUMBER_FATAL3      SeverityNumber = 23
+	SeverityNumber_SEVERITY_NUMBER_FATAL4      SeverityNumber = 24
+)
+
+var SeverityNumber_name = map[int32]string{
+	0:  "SEVERITY_NUMBER_UNSPECIFIED",
+	1:  "SEVERITY_NUMBER_TRACE",
+	2:  "SEVERITY_NUMBER_TRACE2",
+	3:  "SEVERITY_NUMBER_TRACE3",
+	4:  "SEVERITY_NUMBER_TRACE4",
+	5:  "SEVERITY_NUMBER_DEBUG",
+	6:  "SEVERITY_NUMBER_DEBUG2",
+	7:  "SEVERITY_NUMBER_DEBUG3",
+	8:  "SEVERITY_NUMBER_DEBUG4",
+	9:  "SEVERITY_NUMBER_INFO",
+	10: "SEVERITY_NUMBER_INFO2",
+	11: "SEVERITY_NUMBER_INFO3",
+	12: "SEVERITY_NUMBER_INFO4",
+	13: "SEVERITY_NUMBER_WARN",
+	14: "SEVERITY_NUMBER_WARN2",
+	15: "SEVERITY_NUMBER_WARN3",
+	16: "SEVERITY_NUMBER_WARN4",
+	17: "SEVERITY_NUMBER_ERROR",
+	18: "SEVERITY_NUMBER_ERROR2",
+	19: "SEVERITY_NUMBER_ERROR3",
+	20: "SEVERITY_NUMBER_ERROR4",
+	21: "SEVERITY_NUMBER_FATAL",
+	22: "SEVERITY_NUMBER_FATAL2",
+	23: "SEVERITY_NUMBER_FATAL3",
+	24: "SEVERITY_NUMBER_FATAL4",
+}
+var SeverityNumber_value = map[string]int32{
+	"SEVERITY_NUMBER_UNSPECIFIED": 0,
+	"SEVERITY_NUMBER_TRACE":       1,
+	"SEVERITY_NUMBER_TRACE2":      2,
+	"SEVERITY_NUMBER_TRACE3":      3,
+	"SEVERITY_NUMBER_TRACE4":      4,
+	"SEVERITY_NUMBER_DEBUG":       5,
+	"SEVERITY_NUMBER_DEBUG2":      6,
+	"SEVERITY_NUMBER_DEBUG3":      7,
+	"SEVERITY_NUMBER_DEBUG4":      8,
+	"SEVERITY_NUMBER_INFO":        9,
+	"SEVERITY_NUMBER_INFO2":       10,
+	"SEVERITY_NUMBER_INFO3":       11,
+	"SEVERITY_NUMBER_INFO4":       12,
+	"SEVERITY_NUMBER_WARN":        13,
+	"SEVERITY_NUMBER_WARN2":       14,
+	"SEVERITY_NUMBER_WARN3":       15,
+	"SEVERITY_NUMBER_WARN4":       16,
+	"SEVERITY_NUMBER_ERROR":       17,
+	"SEVERITY_NUMBER_ERROR2":      18,
+	"SEVERITY_NUMBER_ERROR3":      19,
+	"SEVERITY_NUMBER_ERROR4":      20,
+	"SEVERITY_NUMBER_FATAL":       21,
+	"SEVERITY_NUMBER_FATAL2":      22,
+	"SEVERITY_NUMBER_FATAL3":      23,
+	"SEVERITY_NUMBER_FATAL4":      24,
+}
+
+func (x SeverityNumber) String() string {
+	return proto.EnumName(SeverityNumber_name, int32(x))
+}
+func (SeverityNumber) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type LogsData struct {
+	ResourceLogs []*ResourceLogs `protobuf:"bytes,1,rep,name=resource_logs,json=resourceLogs" json:"resource_logs,omitempty"`
+}
+
+func (m *LogsData) Reset()                    { *m = LogsData{} }
+func (m *LogsData) String() string            { return proto.CompactTextString(m) }
+func (*LogsData) ProtoMessage()               {}
+func (*LogsData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *LogsData) GetResourceLogs() []*ResourceLogs {
+	if m != nil {
+		return m.ResourceLogs
+	}
+	return nil
+}
+
+type ResourceLogs struct {
+	Resource  *opentelemetry_proto_resource_v1.Resource `protobuf:"bytes,1,opt,name=resource" json:"resource,omitempty"`
+	ScopeLogs []*ScopeLogs                              `protobuf:"bytes,2,rep,name=scope_logs,json=scopeLogs" json:"scope_logs,omitempty"`
+	SchemaUrl string                                    `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl" json:"schema_url,omitempty"`
+}
+
+func (m *ResourceLogs) Reset()                    { *m = ResourceLogs{} }
+func (m *ResourceLogs) String() string            { return proto.CompactTextString(m) }
+func (*ResourceLogs) ProtoMessage()               {}
+func (*ResourceLogs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ResourceLogs) GetResource() *opentelemetry_proto_resource_v1.Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *ResourceLogs) GetScopeLogs() []*ScopeLogs {
+	if m != nil {
+		return m.ScopeLogs
+	}
+	return nil
+}
+
+func (m *ResourceLogs) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+type ScopeLogs struct {
+	Scope      *opentelemetry_proto_common_v1.InstrumentationScope `protobuf:"bytes,1,opt,name=scope" json:"scope,omitempty"`
+	LogRecords []*LogRecord                                        `protobuf:"bytes,2,rep,name=log_records,json=logRecords" json:"log_records,omitempty"`
+	SchemaUrl  string                                              `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl" json:"schema_url,omitempty"`
+}
+
+func (m *ScopeLogs) Reset()                    { *m = ScopeLogs{} }
+func (m *ScopeLogs) String() string            { return proto.CompactTextString(m) }
+func (*ScopeLogs) ProtoMessage()               {}
+func (*ScopeLogs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ScopeLogs) GetScope() *opentelemetry_proto_common_v1.InstrumentationScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *ScopeLogs) GetLogRecords() []*LogRecord {
+	if m != nil {
+		return m.LogRecords
+	}
+	return nil
+}
+
+func (m *ScopeLogs) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+type LogRecord struct {
+	TimeUnixNano           uint64                                    `protobuf:"fixed64,1,opt,name=time_unix_nano,json=timeUnixNano" json:"time_unix_nano,omitempty"`
+	ObservedTimeUnixNano   uint64                                    `protobuf:"fixed64,11,opt,name=observed_time_unix_nano,json=observedTimeUnixNano" json:"observed_time_unix_nano,omitempty"`
+	SeverityNumber         SeverityNumber                            `protobuf:"varint,2,opt,name=severity_number,json=severityNumber,enum=opentelemetry.proto.logs.v1.SeverityNumber" json:"severity_number,omitempty"`
+	SeverityText           string                                    `protobuf:"bytes,3,opt,name=severity_text,json=severityText" json:"severity_text,omitempty"`
+	Body                   *opentelemetry_proto_common_v1.AnyValue   `protobuf:"bytes,5,opt,name=body" json:"body,omitempty"`
+	Attributes             []*opentelemetry_proto_common_v1.KeyValue `protobuf:"bytes,6,rep,name=attributes" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32                                    `protobuf:"varint,7,opt,name=dropped_attributes_count,json=droppedAttributesCount" json:"dropped_attributes_count,omitempty"`
+	Flags                  uint32                                    `protobuf:"fixed32,8,opt,name=flags" json:"flags,omitempty"`
+	TraceId                []byte                                    `protobuf:"bytes,9,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	SpanId                 []byte                                    `protobuf:"bytes,10,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+}
+
+func (m *LogRecord) Reset()                    { *m = LogRecord{} }
+func (m *LogRecord) String() string            { return proto.CompactTextString(m) }
+func (*LogRecord) ProtoMessage()               {}
+func (*LogRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *LogRecord) GetTimeUnixNano() uint64 {
+	if m != nil {
+		return m.TimeUnixNano
+	}
+	return 0
+}
+
+func (m *LogRecord) GetObservedTimeUnixNano() uint64 {
+	if m != nil {
+		return m.ObservedTimeUnixNano
+	}
+	return 0
+}
+
+func (m *LogRecord) GetSeverityNumber() SeverityNumber {
+	if m != nil {
+		return m.SeverityNumber
+	}
+	return SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED
+}
+
+func (m *LogRecord) GetSeverityText() string {
+	if m != nil {
+		return m.SeverityText
+	}
+	return ""
+}
+
+func (m *LogRecord) GetBody() *opentelemetry_proto_common_v1.AnyValue {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *LogRecord) GetAttributes() []*opentelemetry_proto_common_v1.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *LogRecord) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
+func (m *LogRecord) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *LogRecord) GetTraceId() []byte {
+	if m != nil {
+		return m.TraceId
+	}
+	return nil
+}
+
+func (m *LogRecord) GetSpanId() []byte {
+	if m != nil {
+		return m.SpanId
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LogsData)(nil), "opentelemetry.proto.logs.v1.LogsData")
+	proto.RegisterType((*ResourceLogs)(nil), "opentelemetry.proto.logs.v1.ResourceLogs")
+	proto.RegisterType((*ScopeLogs)(nil), "opentelemetry.proto.logs.v1.ScopeLogs")
+	proto.RegisterType((*LogRecord)(nil), "opentelemetry.proto.logs.v1.LogRecord")
+	proto.RegisterEnum("opentelemetry.proto.logs.v1.SeverityNumber", SeverityNumber_name, SeverityNumber_value)
+}
+
+func init() { proto.RegisterFile("logs/v1/logs.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x95, 0x6d, 0x4f, 0xdb, 0x3a,
+	0x14, 0xc7, 0x6f, 0x28, 0x7d, 0x72, 0x1f, 0xf0, 0xf5, 0x2d, 0x25, 0x14, 0x5d, 0xdd, 0x88, 0x3b,
+	0x6d, 0xdd, 0x26, 0xb5, 0x6a, 0xd2, 0x49, 0x93, 0x78, 0x55, 0x20, 0xa0, 0x08, 0x28, 0x93, 0x69,
+	0x99, 0xc6, 0x9b, 0x28, 0x6d, 0x4c, 0x89, 0x94, 0xc6, 0x91, 0xe3, 0x54, 0xed, 0x67, 0x9b, 0xb4,
+	0x37, 0xfb, 0x3c, 0xfb, 0x0e, 0x53, 0xdc, 0xb4, 0x2b, 0x28, 0x01, 0x5e, 0xc5, 0xe7, 0xfc, 0xce,
+	0xff, 0x7f, 0x4e, 0x6c, 0x59, 0x06, 0xc8, 0xa5, 0x93, 0xa0, 0x3d, 0xeb, 0xb4, 0xa3, 0x6f, 0xcb,
+	0x67, 0x94, 0x53, 0x74, 0x40, 0x7d, 0xe2, 0x71, 0xe2, 0x92, 0x29, 0xe1, 0x6c, 0xb1, 0x4c, 0xb6,
+	0x04, 0x9f, 0x75, 0x1a, 0xf5, 0x31, 0x9d, 0x4e, 0xa9, 0x17, 0x49, 0x96, 0xab, 0x25, 0x6f, 0x34,
+	0x18, 0x09, 0x68, 0xc8, 0xc6, 0x24, 0x22, 0xab, 0xf5, 0x92, 0x1d, 0xde, 0x81, 0xc2, 0x25, 0x9d,
+	0x04, 0xa7, 0x16, 0xb7, 0x50, 0x1f, 0x54, 0x56, 0xd4, 0x8c, 0x3c, 0x65, 0x49, 0xc9, 0x34, 0x4b,
+	0xea, 0xfb, 0xd6, 0x33, 0x4d, 0x5b, 0x38, 0x56, 0x44, 0x2e, 0xb8, 0xcc, 0x36, 0xa2, 0xc3, 0xef,
+	0x12, 0x28, 0x6f, 0x62, 0xa4, 0x83, 0xc2, 0xaa, 0x40, 0x96, 0x14, 0x29, 0xd5, 0x7b, 0x3d, 0xe3,
+	0x86, 0x3f, 0x5e, 0x4b, 0x91, 0x0e, 0x40, 0x30, 0xa6, 0x7e, 0x3c, 0xe4, 0x96, 0x18, 0xf2, 0xed,
+	0xb3, 0x43, 0xde, 0x44, 0xe5, 0x62, 0xc2, 0x62, 0xb0, 0x5a, 0xa2, 0x7f, 0x23, 0x9b, 0x07, 0x32,
+	0xb5, 0xcc, 0x90, 0xb9, 0x72, 0x46, 0x91, 0x9a, 0x45, 0x5c, 0x5c, 0x66, 0x86, 0xcc, 0x3d, 0xfc,
+	0x21, 0x81, 0xe2, 0x5a, 0x87, 0x0c, 0x90, 0x15, 0xca, 0x78, 0x6e, 0x2d, 0xb1, 0x5d, 0xbc, 0xeb,
+	0xb3, 0x4e, 0xcb, 0xf0, 0x02, 0xce, 0xc2, 0x29, 0xf1, 0xb8, 0xc5, 0x1d, 0xea, 0x09, 0x1f, 0xbc,
+	0x74, 0x40, 0xe7, 0xa0, 0xe4, 0xd2, 0x89, 0xc9, 0xc8, 0x98, 0x32, 0xfb, 0x75, 0xf3, 0x5f, 0xd2,
+	0x09, 0x16, 0xe5, 0x18, 0xb8, 0xab, 0xe5, 0x8b, 0x3f, 0xf0, 0x2b, 0x03, 0x8a, 0x6b, 0x21, 0x7a,
+	0x03, 0xaa, 0xdc, 0x99, 0x12, 0x33, 0xf4, 0x9c, 0xb9, 0xe9, 0x59, 0x1e, 0x15, 0x7f, 0x92, 0xc3,
+	0xe5, 0x28, 0x3b, 0xf4, 0x9c, 0x79, 0xdf, 0xf2, 0x28, 0xfa, 0x04, 0xf6, 0xe8, 0x28, 0x20, 0x6c,
+	0x46, 0x6c, 0xf3, 0x49, 0x79, 0x49, 0x94, 0xd7, 0x56, 0x78, 0xb0, 0x29, 0x1b, 0x80, 0x9d, 0x80,
+	0xcc, 0x08, 0x73, 0xf8, 0xc2, 0xf4, 0xc2, 0xe9, 0x88, 0x30, 0x79, 0x4b, 0x91, 0x9a, 0x55, 0xf5,
+	0xe3, 0xf3, 0xc7, 0x12, 0x6b, 0xfa, 0x42, 0x82, 0xab, 0xc1, 0xa3, 0x18, 0xfd, 0x0f, 0x2a, 0x6b,
+	0x57, 0x4e, 0xe6, 0x3c, 0xfe, 0xc5, 0xf2, 0x2a, 0x39, 0x20, 0x73, 0x8e, 0x8e, 0xc0, 0xf6, 0x88,
+	0xda, 0x0b, 0x39, 0x2b, 0xce, 0xe5, 0xdd, 0x0b, 0xe7, 0xd2, 0xf3, 0x16, 0xb7, 0x96, 0x1b, 0x12,
+	0x2c, 0x44, 0xe8, 0x1c, 0x00, 0x8b, 0x73, 0xe6, 0x8c, 0x42, 0x4e, 0x02, 0x39, 0xa7, 0x64, 0x5e,
+	0x61, 0x71, 0x41, 0x62, 0x8b, 0x0d, 0x29, 0xfa, 0x0c, 0x64, 0x9b, 0x51, 0xdf, 0x27, 0xb6, 0xf9,
+	0x27, 0x6b, 0x8e, 0x69, 0xe8, 0x71, 0x39, 0xaf, 0x48, 0xcd, 0x0a, 0xae, 0xc7, 0xbc, 0xb7, 0xc6,
+	0x27, 0x11, 0x45, 0x35, 0x90, 0xbd, 0x77, 0xad, 0x49, 0x20, 0x17, 0x14, 0xa9, 0x99, 0xc7, 0xcb,
+	0x00, 0xed, 0x83, 0x02, 0x67, 0xd6, 0x98, 0x98, 0x8e, 0x2d, 0x17, 0x15, 0xa9, 0x59, 0xc6, 0x79,
+	0x11, 0x1b, 0x36, 0xda, 0x03, 0xf9, 0xc0, 0xb7, 0xbc, 0x88, 0x00, 0x41, 0x72, 0x51, 0x68, 0xd8,
+	0x1f, 0x7e, 0x66, 0x41, 0xf5, 0xf1, 0x8e, 0xa2, 0xff, 0xc0, 0xc1, 0x8d, 0x7e, 0xab, 0x63, 0x63,
+	0xf0, 0xcd, 0xec, 0x0f, 0xaf, 0x8e, 0x75, 0x6c, 0x0e, 0xfb, 0x37, 0x5f, 0xf4, 0x13, 0xe3, 0xcc,
+	0xd0, 0x4f, 0xe1, 0x5f, 0x68, 0x1f, 0xec, 0x3e, 0x2d, 0x18, 0xe0, 0xde, 0x89, 0x0e, 0x25, 0xd4,
+	0x00, 0xf5, 0x44, 0xa4, 0xc2, 0xad, 0x54, 0xa6, 0xc1, 0x4c, 0x2a, 0xeb, 0xc2, 0xed, 0xa4, 0x76,
+	0xa7, 0xfa, 0xf1, 0xf0, 0x1c, 0x66, 0x93, 0x64, 0x02, 0xa9, 0x30, 0x97, 0xca, 0x34, 0x98, 0x4f,
+	0x65, 0x5d, 0x58, 0x40, 0x32, 0xa8, 0x3d, 0x65, 0x46, 0xff, 0xec, 0x1a, 0x16, 0x93, 0x06, 0x89,
+	0x88, 0x0a, 0x41, 0x1a, 0xd2, 0x60, 0x29, 0x0d, 0x75, 0x61, 0x39, 0xa9, 0xd5, 0xd7, 0x1e, 0xee,
+	0xc3, 0x4a, 0x92, 0x28, 0x22, 0x2a, 0xac, 0xa6, 0x21, 0x0d, 0xee, 0xa4, 0xa1, 0x2e, 0x84, 0x49,
+	0x48, 0xc7, 0xf8, 0x1a, 0xc3, 0xbf, 0x93, 0x36, 0x43, 0x20, 0x15, 0xa2, 0x54, 0xa6, 0xc1, 0x7f,
+	0x52, 0x59, 0x17, 0xd6, 0x92, 0xda, 0x9d, 0xf5, 0x06, 0xbd, 0x4b, 0xb8, 0x9b, 0x24, 0x13, 0x48,
+	0x85, 0xf5, 0x54, 0xa6, 0xc1, 0xbd, 0x54, 0xd6, 0x85, 0xf2, 0xf1, 0xd5, 0xdd, 0xc5, 0xc4, 0xe1,
+	0x0f, 0xe1, 0x28, 0xba, 0x72, 0x6d, 0x9f, 0x2e, 0xa2, 0xe7, 0x6f, 0xc2, 0xc8, 0x28, 0xe4, 0x9c,
+	0xb0, 0x7b, 0x77, 0xd1, 0xb6, 0x7c, 0xa7, 0x4d, 0xb9, 0xeb, 0xb7, 0xe3, 0xf7, 0xf1, 0xe8, 0xd1,
+	0x7d, 0x35, 0xc5, 0x7d, 0x15, 0x4f, 0x83, 0x39, 0xeb, 0x8c, 0x72, 0x22, 0xd4, 0x7e, 0x0f, 0x00,
+	0xab, 0xdb, 0xff, 0xfa, 0x4d, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,544 @@
+// Code generated by protoc-gen-go.
+// source: metrics/v1/metrics.proto
+// DO NOT EDIT!
+
+/*
+Package opentelemetry_proto_metrics_v1 is a generated protocol buffer package.
+
+It is generated from these files:
+	metrics/v1/metrics.proto
+
+It has these top-level messages:
+	MetricsData
+	ResourceMetrics
+	ScopeMetrics
+	Metric
+	Gauge
+	Sum
+	NumberDataPoint
+*/
+package opentelemetry_proto_metrics_v1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import opentelemetry_proto_common_v1 "github.com/poy/loggrebutterfly/api/otlp/common/v1"
+import opentelemetry_proto_resource_v1 "github.com/poy/loggrebutterfly/api/otlp/resource/v1"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type AggregationTemporality int32
+
+const (
+	AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED AggregationTemporality = 0
+	AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA       AggregationTemporality = 1
+	AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE  AggregationTemporality = 2
+)
+
+var AggregationTemporality_name = map[int32]string{
+	0: "AGGREGATION_TEMPORALITY_UNSPECIFIED",
+	1: "AGGREGATION_TEMPORALITY_DELTA",
+	2: "AGGREGATION_TEMPORALITY_CUMULATIVE",
+}
+var AggregationTemporality_value = map[string]int32{
+	"AGGREGATION_TEMPORALITY_UNSPECIFIED": 0,
+	"AGGREGATION_TEMPORALITY_DELTA":       1,
+	"AGGREGATION_TEMPORALITY_CUMULATIVE":  2,
+}
+
+func (x AggregationTemporality) String() string {
+	return proto.EnumName(AggregationTemporality_name, int32(x))
+}
+func (AggregationTemporality) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type MetricsData struct {
+	ResourceMetrics []*ResourceMetrics `protobuf:"bytes,1,rep,name=resource_metrics,json=resourceMetrics" json:"resource_metrics,omitempty"`
+}
+
+func (m *MetricsData) Reset()                    { *m = MetricsData{} }
+func (m *MetricsData) String() string            { return proto.CompactTextString(m) }
+func (*MetricsData) ProtoMessage()               {}
+func (*MetricsData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *MetricsData) GetResourceMetrics() []*ResourceMetrics {
+	if m != nil {
+		return m.ResourceMetrics
+	}
+	return nil
+}
+
+type ResourceMetrics struct {
+	Resource     *opentelemetry_proto_resource_v1.Resource `protobuf:"bytes,1,opt,name=resource" json:"resource,omitempty"`
+	ScopeMetrics []*ScopeMetrics                           `protobuf:"bytes,2,rep,name=scope_metrics,json=scopeMetrics" json:"scope_metrics,omitempty"`
+	SchemaUrl    string                                    `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl" json:"schema_url,omitempty"`
+}
+
+func (m *ResourceMetrics) Reset()                    { *m = ResourceMetrics{} }
+func (m *ResourceMetrics) String() string            { return proto.CompactTextString(m) }
+func (*ResourceMetrics) ProtoMessage()               {}
+func (*ResourceMetrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ResourceMetrics) GetResource() *opentelemetry_proto_resource_v1.Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *ResourceMetrics) GetScopeMetrics() []*ScopeMetrics {
+	if m != nil {
+		return m.ScopeMetrics
+	}
+	return nil
+}
+
+func (m *ResourceMetrics) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+type ScopeMetrics struct {
+	Scope     *opentelemetry_proto_common_v1.InstrumentationScope `protobuf:"bytes,1,opt,name=scope" json:"scope,omitempty"`
+	Metrics   []*Metric                                           `protobuf:"bytes,2,rep,name=metrics" json:"metrics,omitempty"`
+	SchemaUrl string                                              `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl" json:"schema_url,omitempty"`
+}
+
+func (m *ScopeMetrics) Reset()                    { *m = ScopeMetrics{} }
+func (m *ScopeMetrics) String() string            { return proto.CompactTextString(m) }
+func (*ScopeMetrics) ProtoMessage()               {}
+func (*ScopeMetrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ScopeMetrics) GetScope() *opentelemetry_proto_common_v1.InstrumentationScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *ScopeMetrics) GetMetrics() []*Metric {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *ScopeMetrics) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+// Only gauges and sums are ingested. Histograms (9), exponential
+// histograms (10) and summaries (11) are left out and skipped when decoded.
+type Metric struct {
+	Name        string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Unit        string `protobuf:"bytes,3,opt,name=unit" json:"unit,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//	*Metric_Gauge
+	//	*Metric_Sum
+	Data isMetric_Data `protobuf_oneof:"data"`
+}
+
+func (m *Metric) Reset()                    { *m = Metric{} }
+func (m *Metric) String() string            { return proto.CompactTextString(m) }
+func (*Metric) ProtoMessage()               {}
+func (*Metric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type isMetric_Data interface {
+	isMetric_Data()
+}
+
+type Metric_Gauge struct {
+	Gauge *Gauge `protobuf:"bytes,5,opt,name=gauge,oneof"`
+}
+type Metric_Sum struct {
+	Sum *Sum `protobuf:"bytes,7,opt,name=sum,oneof"`
+}
+
+func (*Metric_Gauge) isMetric_Data() {}
+func (*Metric_Sum) isMetric_Data()   {}
+
+func (m *Metric) GetData() isMetric_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Metric) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Metric) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Metric) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *Metric) GetGauge() *Gauge {
+	if x, ok := m.GetData().(*Metric_Gauge); ok {
+		return x.Gauge
+	}
+	return nil
+}
+
+func (m *Metric) GetSum() *Sum {
+	if x, ok := m.GetData().(*Metric_Sum); ok {
+		return x.Sum
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Metric) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Metric_OneofMarshaler, _Metric_OneofUnmarshaler, _Metric_OneofSizer, []interface{}{
+		(*Metric_Gauge)(nil),
+		(*Metric_Sum)(nil),
+	}
+}
+
+func _Metric_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Metric)
+	// data
+	switch x := m.Data.(type) {
+	case *Metric_Gauge:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Gauge); err != nil {
+			return err
+		}
+	case *Metric_Sum:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sum); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Metric.Data has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Metric_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Metric)
+	switch tag {
+	case 5: // data.gauge
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Gauge)
+		err := b.DecodeMessage(msg)
+		m.Data = &Metric_Gauge{msg}
+		return true, err
+	case 7: // data.sum
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Sum)
+		err := b.DecodeMessage(msg)
+		m.Data = &Metric_Sum{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Metric_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Metric)
+	// data
+	switch x := m.Data.(type) {
+	case *Metric_Gauge:
+		s := proto.Size(x.Gauge)
+		n += proto.SizeVarint(5<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Metric_Sum:
+		s := proto.Size(x.Sum)
+		n += proto.SizeVarint(7<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Gauge struct {
+	DataPoints []*NumberDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints" json:"data_points,omitempty"`
+}
+
+func (m *Gauge) Reset()                    { *m = Gauge{} }
+func (m *Gauge) String() string            { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()               {}
+func (*Gauge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Gauge) GetDataPoints() []*NumberDataPoint {
+	if m != nil {
+		return m.DataPoints
+	}
+	return nil
+}
+
+type Sum struct {
+	DataPoints             []*NumberDataPoint     `protobuf:"bytes,1,rep,name=data_points,json=dataPoints" json:"data_points,omitempty"`
+	AggregationTemporality AggregationTemporality `protobuf:"varint,2,opt,name=aggregation_temporality,json=aggregationTemporality,enum=opentelemetry.proto.metrics.v1.AggregationTemporality" json:"aggregation_temporality,omitempty"`
+	IsMonotonic            bool                   `protobuf:"varint,3,opt,name=is_monotonic,json=isMonotonic" json:"is_monotonic,omitempty"`
+}
+
+func (m *Sum) Reset()                    { *m = Sum{} }
+func (m *Sum) String() string            { return proto.CompactTextString(m) }
+func (*Sum) ProtoMessage()               {}
+func (*Sum) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *Sum) GetDataPoints() []*NumberDataPoint {
+	if m != nil {
+		return m.DataPoints
+	}
+	return nil
+}
+
+func (m *Sum) GetAggregationTemporality() AggregationTemporality {
+	if m != nil {
+		return m.AggregationTemporality
+	}
+	return AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED
+}
+
+func (m *Sum) GetIsMonotonic() bool {
+	if m != nil {
+		return m.IsMonotonic
+	}
+	return false
+}
+
+// Exemplars (5) are left out.
+type NumberDataPoint struct {
+	Attributes        []*opentelemetry_proto_common_v1.KeyValue `protobuf:"bytes,7,rep,name=attributes" json:"attributes,omitempty"`
+	StartTimeUnixNano uint64                                    `protobuf:"fixed64,2,opt,name=start_time_unix_nano,json=startTimeUnixNano" json:"start_time_unix_nano,omitempty"`
+	TimeUnixNano      uint64                                    `protobuf:"fixed64,3,opt,name=time_unix_nano,json=timeUnixNano" json:"time_unix_nano,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*NumberDataPoint_AsDouble
+	//	*NumberDataPoint_AsInt
+	Value isNumberDataPoint_Value `protobuf_oneof:"value"`
+	Flags uint32                  `protobuf:"varint,8,opt,name=flags" json:"flags,omitempty"`
+}
+
+func (m *NumberDataPoint) Reset()                    { *m = NumberDataPoint{} }
+func (m *NumberDataPoint) String() string            { return proto.CompactTextString(m) }
+func (*NumberDataPoint) ProtoMessage()               {}
+func (*NumberDataPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+type isNumberDataPoint_Value interface {
+	isNumberDataPoint_Value()
+}
+
+type NumberDataPoint_AsDouble struct {
+	AsDouble float64 `protobuf:"fixed64,4,opt,name=as_double,json=asDouble,oneof"`
+}
+type NumberDataPoint_AsInt struct {
+	AsInt int64 `protobuf:"fixed64,6,opt,name=as_int,json=asInt,oneof"`
+}
+
+func (*NumberDataPoint_AsDouble) isNumberDataPoint_Value() {}
+func (*NumberDataPoint_AsInt) isNumberDataPoint_Value()    {}
+
+func (m *NumberDataPoint) GetValue() isNumberDataPoint_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *NumberDataPoint) GetAttributes() []*opentelemetry_proto_common_v1.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *NumberDataPoint) GetStartTimeUnixNano() uint64 {
+	if m != nil {
+		return m.StartTimeUnixNano
+	}
+	return 0
+}
+
+func (m *NumberDataPoint) GetTimeUnixNano() uint64 {
+	if m != nil {
+		return m.TimeUnixNano
+	}
+	return 0
+}
+
+func (m *NumberDataPoint) GetAsDouble() float64 {
+	if x, ok := m.GetValue().(*NumberDataPoint_AsDouble); ok {
+		return x.AsDouble
+	}
+	return 0
+}
+
+func (m *NumberDataPoint) GetAsInt() int64 {
+	if x, ok := m.GetValue().(*NumberDataPoint_AsInt); ok {
+		return x.AsInt
+	}
+	return 0
+}
+
+func (m *NumberDataPoint) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*NumberDataPoint) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _NumberDataPoint_OneofMarshaler, _NumberDataPoint_OneofUnmarshaler, _NumberDataPoint_OneofSizer, []interface{}{
+		(*NumberDataPoint_AsDouble)(nil),
+		(*NumberDataPoint_AsInt)(nil),
+	}
+}
+
+func _NumberDataPoint_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*NumberDataPoint)
+	// value
+	switch x := m.Value.(type) {
+	case *NumberDataPoint_AsDouble:
+		b.EncodeVarint(4<<3 | proto.WireFixed64)
+		b.EncodeFixed64(math.Float64bits(x.AsDouble))
+	case *NumberDataPoint_AsInt:
+		b.EncodeVarint(6<<3 | proto.WireFixed64)
+		b.EncodeVarint(uint64(x.AsInt))
+	case nil:
+	default:
+		return fmt.Errorf("NumberDataPoint.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _NumberDataPoint_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*NumberDataPoint)
+	switch tag {
+	case 4: // value.as_double
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed64()
+		m.Value = &NumberDataPoint_AsDouble{math.Float64frombits(x)}
+		return true, err
+	case 6: // value.as_int
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &NumberDataPoint_AsInt{int64(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _NumberDataPoint_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*NumberDataPoint)
+	// value
+	switch x := m.Value.(type) {
+	case *NumberDataPoint_AsDouble:
+		n += proto.SizeVarint(4<<3 | proto.WireFixed64)
+		n += 8
+	case *NumberDataPoint_AsInt:
+		n += proto.SizeVarint(6<<3 | proto.WireFixed64)
+		n += proto.SizeVarint(uint64(x.AsInt))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*MetricsData)(nil), "opentelemetry.proto.metrics.v1.MetricsData")
+	proto.RegisterType((*ResourceMetrics)(nil), "opentelemetry.proto.metrics.v1.ResourceMetrics")
+	proto.RegisterType((*ScopeMetrics)(nil), "opentelemetry.proto.metrics.v1.ScopeMetrics")
+	proto.RegisterType((*Metric)(nil), "opentelemetry.proto.metrics.v1.Metric")
+	proto.RegisterType((*Gauge)(nil), "opentelemetry.proto.metrics.v1.Gauge")
+	proto.RegisterType((*Sum)(nil), "opentelemetry.proto.metrics.v1.Sum")
+	proto.RegisterType((*NumberDataPoint)(nil), "opentelemetry.proto.metrics.v1.NumberDataPoint")
+	proto.RegisterEnum("opentelemetry.proto.metrics.v1.AggregationTemporality", AggregationTemporality_name, AggregationTemporality_value)
+}
+
+func init() { proto.RegisterFile("metrics/v1/metrics.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0x22, 0x37,
+	0x18, 0x65, 0x20, 0xfc, 0x7d, 0xb0, 0x1b, 0x6a, 0xad, 0xb2, 0xa3, 0x48, 0xa9, 0x58, 0xb6, 0xdd,
+	0xa5, 0x55, 0xc5, 0x88, 0x44, 0x6a, 0x2f, 0xaa, 0x4a, 0x25, 0x81, 0x92, 0x51, 0x03, 0x21, 0x06,
+	0x22, 0x25, 0x37, 0x23, 0x03, 0x0e, 0xb1, 0x34, 0x63, 0x8f, 0xc6, 0x1e, 0x14, 0xde, 0xa0, 0x17,
+	0x7d, 0xa2, 0x3e, 0x42, 0x2f, 0xfb, 0x1a, 0x7d, 0x89, 0x6a, 0x3c, 0x4c, 0x20, 0x11, 0x29, 0xb9,
+	0xd8, 0xbb, 0xcf, 0xc7, 0xe7, 0x1c, 0x9f, 0xcf, 0x9f, 0x61, 0xc0, 0xf4, 0xa8, 0x0a, 0xd8, 0x54,
+	0x5a, 0x8b, 0xa6, 0xb5, 0x2a, 0x1b, 0x7e, 0x20, 0x94, 0x40, 0x5f, 0x0b, 0x9f, 0x72, 0x45, 0x5d,
+	0x1a, 0xc1, 0xcb, 0x18, 0x6c, 0x24, 0x94, 0x45, 0xf3, 0xf0, 0x60, 0x2a, 0x3c, 0x4f, 0xf0, 0x48,
+	0x18, 0x57, 0x31, 0xe5, 0xf0, 0x30, 0xa0, 0x52, 0x84, 0xc1, 0x94, 0x46, 0x3b, 0x49, 0x1d, 0xef,
+	0xd5, 0x18, 0x94, 0x7a, 0xb1, 0x43, 0x9b, 0x28, 0x82, 0x6e, 0xa1, 0x92, 0x10, 0x9c, 0x95, 0xb3,
+	0x69, 0x54, 0x33, 0xf5, 0xd2, 0xb1, 0xd5, 0xf8, 0xff, 0xd3, 0x1b, 0x78, 0xa5, 0x5b, 0xd9, 0xe1,
+	0xfd, 0xe0, 0x29, 0x50, 0xfb, 0xdb, 0x80, 0xfd, 0x67, 0x24, 0xd4, 0x81, 0x42, 0x42, 0x33, 0x8d,
+	0xaa, 0x51, 0x2f, 0x1d, 0x7f, 0xb7, 0xf5, 0x9c, 0xc7, 0xd4, 0x1b, 0x07, 0xe1, 0x47, 0x29, 0xba,
+	0x82, 0x37, 0x72, 0x2a, 0xfc, 0x75, 0xe6, 0xb4, 0xce, 0xfc, 0xc3, 0xae, 0xcc, 0xc3, 0x48, 0x94,
+	0x04, 0x2e, 0xcb, 0x8d, 0x15, 0x3a, 0x02, 0x90, 0xd3, 0x7b, 0xea, 0x11, 0x27, 0x0c, 0x5c, 0x33,
+	0x53, 0x35, 0xea, 0x45, 0x5c, 0x8c, 0x91, 0x71, 0xe0, 0xd6, 0xfe, 0x32, 0xa0, 0xbc, 0xa9, 0x46,
+	0x36, 0x64, 0xb5, 0x7e, 0xd5, 0xc6, 0xc9, 0xd6, 0xa3, 0x57, 0x63, 0x59, 0x34, 0x1b, 0x36, 0x97,
+	0x2a, 0x08, 0x3d, 0xca, 0x15, 0x51, 0x4c, 0x70, 0x6d, 0x85, 0x63, 0x07, 0xf4, 0x2b, 0xe4, 0x9f,
+	0xf6, 0xf1, 0x69, 0x57, 0x1f, 0x71, 0x08, 0x9c, 0xf7, 0x5e, 0x17, 0xfe, 0x1f, 0x03, 0x72, 0xb1,
+	0x04, 0x21, 0xd8, 0xe3, 0xc4, 0x8b, 0x53, 0x17, 0xb1, 0xae, 0x51, 0x15, 0x4a, 0x33, 0x2a, 0xa7,
+	0x01, 0xf3, 0xa3, 0x68, 0x66, 0x5a, 0x6f, 0x6d, 0x42, 0x91, 0x2a, 0xe4, 0x4c, 0xad, 0x9c, 0x75,
+	0x8d, 0x7e, 0x81, 0xec, 0x9c, 0x84, 0x73, 0x6a, 0x66, 0xf5, 0x05, 0x7c, 0xbb, 0x2b, 0x73, 0x37,
+	0x22, 0x9f, 0xa7, 0x70, 0xac, 0x42, 0x3f, 0x41, 0x46, 0x86, 0x9e, 0x99, 0xd7, 0xe2, 0x8f, 0x3b,
+	0x07, 0x17, 0x7a, 0xe7, 0x29, 0x1c, 0x29, 0x4e, 0x73, 0xb0, 0x37, 0x23, 0x8a, 0xd4, 0x6e, 0x20,
+	0xab, 0x2d, 0xd1, 0x00, 0x4a, 0x11, 0xe0, 0xf8, 0x82, 0x71, 0xf5, 0xea, 0xe7, 0xdb, 0x0f, 0xbd,
+	0x09, 0x0d, 0xa2, 0x1f, 0xc1, 0x20, 0xd2, 0x61, 0x98, 0x25, 0xa5, 0xac, 0xfd, 0x6b, 0x40, 0x66,
+	0x18, 0x7a, 0x5f, 0xde, 0x19, 0x09, 0x78, 0x4f, 0xe6, 0xf3, 0x80, 0xce, 0xf5, 0x2b, 0x70, 0x14,
+	0xf5, 0x7c, 0x11, 0x10, 0x97, 0xa9, 0xa5, 0xbe, 0xf6, 0xb7, 0xc7, 0x3f, 0xee, 0x72, 0x6f, 0xad,
+	0xe5, 0xa3, 0xb5, 0x1a, 0x1f, 0x90, 0xad, 0x38, 0xfa, 0x00, 0x65, 0x26, 0x1d, 0x4f, 0x70, 0xa1,
+	0x04, 0x67, 0x53, 0x3d, 0xc1, 0x02, 0x2e, 0x31, 0xd9, 0x4b, 0xa0, 0xda, 0x1f, 0x69, 0xd8, 0x7f,
+	0x96, 0x19, 0x75, 0x01, 0x88, 0x52, 0x01, 0x9b, 0x84, 0x8a, 0x4a, 0x33, 0xaf, 0x1b, 0xff, 0xbc,
+	0xe3, 0x89, 0xff, 0x4e, 0x97, 0xd7, 0xc4, 0x0d, 0x29, 0xde, 0x90, 0x22, 0x0b, 0xde, 0x49, 0x45,
+	0x02, 0xe5, 0x28, 0xe6, 0x51, 0x27, 0xe4, 0xec, 0xc1, 0xe1, 0x84, 0x0b, 0xdd, 0x6d, 0x0e, 0x7f,
+	0xa5, 0xf7, 0x46, 0xcc, 0xa3, 0x63, 0xce, 0x1e, 0xfa, 0x84, 0x0b, 0xf4, 0x0d, 0xbc, 0x7d, 0x46,
+	0xcd, 0x68, 0x6a, 0x59, 0x6d, 0xb2, 0x8e, 0xa0, 0x48, 0xa4, 0x33, 0x13, 0xe1, 0xc4, 0xa5, 0xe6,
+	0x5e, 0xd5, 0xa8, 0x1b, 0xe7, 0x29, 0x5c, 0x20, 0xb2, 0xad, 0x11, 0xf4, 0x1e, 0x72, 0x44, 0x3a,
+	0x8c, 0x2b, 0x33, 0x57, 0x35, 0xea, 0x95, 0xe8, 0xd5, 0x11, 0x69, 0x73, 0x85, 0xde, 0x41, 0xf6,
+	0xce, 0x25, 0x73, 0x69, 0x16, 0xaa, 0x46, 0xfd, 0x0d, 0x8e, 0x17, 0xa7, 0x79, 0xc8, 0x2e, 0xa2,
+	0xe4, 0xdf, 0xff, 0x69, 0xc0, 0xc1, 0xf6, 0x0b, 0x46, 0x9f, 0xe1, 0x63, 0xab, 0xdb, 0xc5, 0x9d,
+	0x6e, 0x6b, 0x64, 0x5f, 0xf6, 0x9d, 0x51, 0xa7, 0x37, 0xb8, 0xc4, 0xad, 0x0b, 0x7b, 0x74, 0xe3,
+	0x8c, 0xfb, 0xc3, 0x41, 0xe7, 0xcc, 0xfe, 0xcd, 0xee, 0xb4, 0x2b, 0x29, 0xf4, 0x01, 0x8e, 0x5e,
+	0x22, 0xb6, 0x3b, 0x17, 0xa3, 0x56, 0xc5, 0x40, 0x9f, 0xa0, 0xf6, 0x12, 0xe5, 0x6c, 0xdc, 0x1b,
+	0x5f, 0xb4, 0x46, 0xf6, 0x75, 0xa7, 0x92, 0x3e, 0x1d, 0xde, 0x5e, 0xcd, 0x99, 0xba, 0x0f, 0x27,
+	0xd1, 0x2d, 0x5b, 0xbe, 0x58, 0x5a, 0xae, 0x88, 0xd2, 0x4d, 0x42, 0xa5, 0x68, 0x70, 0xe7, 0x2e,
+	0x2d, 0xe2, 0x33, 0x4b, 0x28, 0xd7, 0xb7, 0xd6, 0x1f, 0x91, 0x9f, 0x9f, 0x4c, 0xc9, 0xd1, 0x53,
+	0x4a, 0xfe, 0x27, 0x9d, 0x45, 0x73, 0x92, 0xd3, 0xc8, 0xc9, 0x7f, 0x03, 0x00, 0x69, 0xed, 0x11,
+	0x85, 0x78, 0x06, 0x00, 0x00,
+}
@@ -0,0 +1,79 @@
+// Code generated by protoc-gen-go.
+// source: resource/v1/resource.proto
+// DO NOT EDIT!
+
+/*
+Package opentelemetry_proto_resource_v1 is a generated protocol buffer package.
+
+It is generated from these files:
+	resource/v1/resource.proto
+
+It has these top-level messages:
+	Resource
+*/
+package opentelemetry_proto_resource_v1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import opentelemetry_proto_common_v1 "github.com/poy/loggrebutterfly/api/otlp/common/v1"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Resource struct {
+	Attributes             []*opentelemetry_proto_common_v1.KeyValue `protobuf:"bytes,1,rep,name=attributes" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32                                    `protobuf:"varint,2,opt,name=dropped_attributes_count,json=droppedAttributesCount" json:"dropped_attributes_count,omitempty"`
+}
+
+func (m *Resource) Reset()                    { *m = Resource{} }
+func (m *Resource) String() string            { return proto.CompactTextString(m) }
+func (*Resource) ProtoMessage()               {}
+func (*Resource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Resource) GetAttributes() []*opentelemetry_proto_common_v1.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Resource) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Resource)(nil), "opentelemetry.proto.resource.v1.Resource")
+}
+
+func init() { proto.RegisterFile("resource/v1/resource.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x4a, 0x2d, 0xce,
+	0x2f, 0x2d, 0x4a, 0x4e, 0xd5, 0x2f, 0x33, 0xd4, 0x87, 0xb1, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
+	0x85, 0xe4, 0xf3, 0x0b, 0x52, 0xf3, 0x4a, 0x52, 0x73, 0x52, 0x73, 0x53, 0x4b, 0x8a, 0x2a, 0x21,
+	0x82, 0x7a, 0x70, 0x35, 0x65, 0x86, 0x52, 0x62, 0xc9, 0xf9, 0xb9, 0xb9, 0xf9, 0x79, 0x20, 0xad,
+	0x10, 0x16, 0x44, 0x8d, 0x52, 0x2f, 0x23, 0x17, 0x47, 0x10, 0x54, 0x9d, 0x90, 0x3b, 0x17, 0x57,
+	0x62, 0x49, 0x49, 0x51, 0x66, 0x52, 0x69, 0x49, 0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7,
+	0x91, 0xba, 0x1e, 0x36, 0xa3, 0xa1, 0x66, 0x94, 0x19, 0xea, 0x79, 0xa7, 0x56, 0x86, 0x25, 0xe6,
+	0x94, 0xa6, 0x06, 0x21, 0x69, 0x15, 0xb2, 0xe0, 0x92, 0x48, 0x29, 0xca, 0x2f, 0x28, 0x48, 0x4d,
+	0x89, 0x47, 0x88, 0xc6, 0x27, 0xe7, 0x97, 0xe6, 0x95, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x06,
+	0x89, 0x41, 0xe5, 0x1d, 0xe1, 0xd2, 0xce, 0x20, 0x59, 0xa7, 0xd0, 0xa8, 0xe0, 0xf4, 0xcc, 0x92,
+	0x8c, 0xd2, 0x24, 0x90, 0x15, 0xfa, 0x05, 0xf9, 0x95, 0xfa, 0x39, 0xf9, 0xe9, 0xe9, 0x45, 0xa9,
+	0x49, 0xa5, 0x25, 0x25, 0xa9, 0x45, 0x69, 0x39, 0x95, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xf9, 0x25,
+	0x39, 0x05, 0xfa, 0x48, 0xc1, 0x61, 0x8d, 0xe2, 0xc6, 0x78, 0xb0, 0x1b, 0xe3, 0x61, 0xf2, 0xf1,
+	0x65, 0x86, 0x49, 0x6c, 0x60, 0x21, 0x63, 0xc0, 0x00, 0x52, 0xf9, 0xbe, 0x06, 0x44, 0x01, 0x00,
+	0x00,
+}
@@ -0,0 +1,3 @@
+package prometheus
+
+//go:generate ./generate.sh
@@ -0,0 +1,21 @@
+#!/bin/bash
+
+dir_resolve()
+{
+    cd "$1" 2>/dev/null || return $?  # cd to desired directory; if fail, quell any error messages but return exit status
+    echo "`pwd -P`" # output full, link-resolved path
+}
+
+set -e
+
+# The protos are the subset of the Prometheus remote write protos (prompb)
+# needed to ingest samples. Field numbers match upstream so the wire format
+# is compatible.
+
+target=`dirname $0`
+target=`dir_resolve $target`
+cd $target
+
+go get github.com/golang/protobuf/{proto,protoc-gen-go}
+
+protoc *.proto --go_out=plugins=grpc:. --proto_path=.
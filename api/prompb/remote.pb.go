@@ -0,0 +1,80 @@
+// Code generated by protoc-gen-go.
+// source: remote.proto
+// DO NOT EDIT!
+
+/*
+Package prometheus is a generated protocol buffer package.
+
+It is generated from these files:
+	remote.proto
+	types.proto
+
+It has these top-level messages:
+	WriteRequest
+	MetricMetadata
+	Sample
+	TimeSeries
+	Label
+*/
+package prometheus
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type WriteRequest struct {
+	Timeseries []*TimeSeries     `protobuf:"bytes,1,rep,name=timeseries" json:"timeseries,omitempty"`
+	Metadata   []*MetricMetadata `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty"`
+}
+
+func (m *WriteRequest) Reset()                    { *m = WriteRequest{} }
+func (m *WriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteRequest) ProtoMessage()               {}
+func (*WriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *WriteRequest) GetTimeseries() []*TimeSeries {
+	if m != nil {
+		return m.Timeseries
+	}
+	return nil
+}
+
+func (m *WriteRequest) GetMetadata() []*MetricMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*WriteRequest)(nil), "prometheus.WriteRequest")
+}
+
+func init() { proto.RegisterFile("remote.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 191 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x4c, 0x8f, 0x31, 0x4b, 0xc6, 0x30,
+	0x14, 0x45, 0xa9, 0x15, 0x29, 0x69, 0x07, 0xe9, 0x20, 0xa5, 0x93, 0x38, 0x39, 0x25, 0xa0, 0xd2,
+	0xc5, 0xcd, 0x51, 0xe8, 0x52, 0x05, 0xc1, 0x2d, 0xa9, 0xd7, 0x36, 0xd0, 0x90, 0xf8, 0xf2, 0x32,
+	0x74, 0xf7, 0x87, 0x8b, 0x55, 0xbe, 0x76, 0xbe, 0xe7, 0x1c, 0xb8, 0xa2, 0x22, 0x38, 0xcf, 0x90,
+	0x81, 0x3c, 0xfb, 0x5a, 0x04, 0xf2, 0x0e, 0x3c, 0x23, 0xc5, 0xb6, 0xe4, 0x35, 0x20, 0xfe, 0x0d,
+	0x37, 0xdf, 0x99, 0xa8, 0xde, 0xc8, 0x32, 0x06, 0x7c, 0x25, 0x44, 0xae, 0x3b, 0x21, 0xd8, 0x3a,
+	0x44, 0x90, 0x45, 0x6c, 0xb2, 0xeb, 0xfc, 0xb6, 0xbc, 0xbb, 0x92, 0xbb, 0x2e, 0x5f, 0xad, 0xc3,
+	0xcb, 0xb6, 0x0e, 0x07, 0xb2, 0xee, 0x44, 0xe1, 0xc0, 0xfa, 0x43, 0xb3, 0x6e, 0xf2, 0xcd, 0x6a,
+	0x8f, 0x56, 0x0f, 0x26, 0x3b, 0xf6, 0xff, 0xc4, 0x70, 0x62, 0x9f, 0xcf, 0x8b, 0xb3, 0xcb, 0xfc,
+	0xa9, 0x7b, 0x7f, 0x98, 0x2c, 0xcf, 0xc9, 0xc8, 0xd1, 0x3b, 0x15, 0xfc, 0xaa, 0x16, 0x3f, 0x4d,
+	0x04, 0x93, 0x98, 0x41, 0x9f, 0xcb, 0xaa, 0x74, 0xb0, 0xea, 0xb7, 0x17, 0xcc, 0xe3, 0x9e, 0x35,
+	0x17, 0xdb, 0x8b, 0xfb, 0x9f, 0x01, 0x00, 0xfb, 0x83, 0x5d, 0xad, 0xee, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go.
+// source: types.proto
+// DO NOT EDIT!
+
+package prometheus
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type MetricMetadata_MetricType int32
+
+const (
+	MetricMetadata_UNKNOWN        MetricMetadata_MetricType = 0
+	MetricMetadata_COUNTER        MetricMetadata_MetricType = 1
+	MetricMetadata_GAUGE          MetricMetadata_MetricType = 2
+	MetricMetadata_HISTOGRAM      MetricMetadata_MetricType = 3
+	MetricMetadata_GAUGEHISTOGRAM MetricMetadata_MetricType = 4
+	MetricMetadata_SUMMARY        MetricMetadata_MetricType = 5
+	MetricMetadata_INFO           MetricMetadata_MetricType = 6
+	MetricMetadata_STATESET       MetricMetadata_MetricType = 7
+)
+
+var MetricMetadata_MetricType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "COUNTER",
+	2: "GAUGE",
+	3: "HISTOGRAM",
+	4: "GAUGEHISTOGRAM",
+	5: "SUMMARY",
+	6: "INFO",
+	7: "STATESET",
+}
+var MetricMetadata_MetricType_value = map[string]int32{
+	"UNKNOWN":        0,
+	"COUNTER":        1,
+	"GAUGE":          2,
+	"HISTOGRAM":      3,
+	"GAUGEHISTOGRAM": 4,
+	"SUMMARY":        5,
+	"INFO":           6,
+	"STATESET":       7,
+}
+
+func (x MetricMetadata_MetricType) String() string {
+	return proto.EnumName(MetricMetadata_MetricType_name, int32(x))
+}
+func (MetricMetadata_MetricType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{0, 0}
+}
+
+type MetricMetadata struct {
+	Type             MetricMetadata_MetricType `protobuf:"varint,1,opt,name=type,enum=prometheus.MetricMetadata_MetricType" json:"type,omitempty"`
+	MetricFamilyName string                    `protobuf:"bytes,2,opt,name=metric_family_name,json=metricFamilyName" json:"metric_family_name,omitempty"`
+	Help             string                    `protobuf:"bytes,4,opt,name=help" json:"help,omitempty"`
+	Unit             string                    `protobuf:"bytes,5,opt,name=unit" json:"unit,omitempty"`
+}
+
+func (m *MetricMetadata) Reset()                    { *m = MetricMetadata{} }
+func (m *MetricMetadata) String() string            { return proto.CompactTextString(m) }
+func (*MetricMetadata) ProtoMessage()               {}
+func (*MetricMetadata) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *MetricMetadata) GetType() MetricMetadata_MetricType {
+	if m != nil {
+		return m.Type
+	}
+	return MetricMetadata_UNKNOWN
+}
+
+func (m *MetricMetadata) GetMetricFamilyName() string {
+	if m != nil {
+		return m.MetricFamilyName
+	}
+	return ""
+}
+
+func (m *MetricMetadata) GetHelp() string {
+	if m != nil {
+		return m.Help
+	}
+	return ""
+}
+
+func (m *MetricMetadata) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+type Sample struct {
+	Value float64 `protobuf:"fixed64,1,opt,name=value" json:"value,omitempty"`
+	// timestamp is in milliseconds.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *Sample) Reset()                    { *m = Sample{} }
+func (m *Sample) String() string            { return proto.CompactTextString(m) }
+func (*Sample) ProtoMessage()               {}
+func (*Sample) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
+
+func (m *Sample) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Sample) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// Exemplars (3) and native histograms (4) are left out.
+type TimeSeries struct {
+	Labels  []*Label  `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+	Samples []*Sample `protobuf:"bytes,2,rep,name=samples" json:"samples,omitempty"`
+}
+
+func (m *TimeSeries) Reset()                    { *m = TimeSeries{} }
+func (m *TimeSeries) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeries) ProtoMessage()               {}
+func (*TimeSeries) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
+
+func (m *TimeSeries) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *TimeSeries) GetSamples() []*Sample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+type Label struct {
+	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *Label) Reset()                    { *m = Label{} }
+func (m *Label) String() string            { return proto.CompactTextString(m) }
+func (*Label) ProtoMessage()               {}
+func (*Label) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
+
+func (m *Label) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Label) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MetricMetadata)(nil), "prometheus.MetricMetadata")
+	proto.RegisterType((*Sample)(nil), "prometheus.Sample")
+	proto.RegisterType((*TimeSeries)(nil), "prometheus.TimeSeries")
+	proto.RegisterType((*Label)(nil), "prometheus.Label")
+	proto.RegisterEnum("prometheus.MetricMetadata_MetricType", MetricMetadata_MetricType_name, MetricMetadata_MetricType_value)
+}
+
+func init() { proto.RegisterFile("types.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x54, 0x52, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0x36, 0x6d, 0xd2, 0x6e, 0x5f, 0xb5, 0xc4, 0x87, 0x87, 0x1c, 0x3c, 0x94, 0x80, 0x50, 0x61,
+	0x69, 0x71, 0x15, 0x41, 0xf4, 0x52, 0xa5, 0x5b, 0x17, 0x4d, 0x0a, 0x93, 0x04, 0xd1, 0xcb, 0x32,
+	0x59, 0xdf, 0xb6, 0x81, 0x99, 0xcd, 0x90, 0x4c, 0x84, 0xfc, 0x2f, 0xfe, 0xb1, 0x32, 0x33, 0x2b,
+	0xd9, 0xbd, 0xbd, 0xef, 0xc7, 0xe3, 0x7b, 0xf9, 0x32, 0x30, 0xd7, 0xbd, 0xa2, 0x76, 0xad, 0x9a,
+	0x5a, 0xd7, 0x08, 0xaa, 0xa9, 0x25, 0xe9, 0x13, 0x75, 0x6d, 0xfc, 0x77, 0x04, 0x8b, 0x84, 0x74,
+	0x53, 0xdd, 0x24, 0xa4, 0xf9, 0x6f, 0xae, 0x39, 0x7e, 0x00, 0xdf, 0xb8, 0x23, 0x6f, 0xe9, 0xad,
+	0x16, 0x17, 0xaf, 0xd6, 0x83, 0x7b, 0xfd, 0xd8, 0x79, 0x0f, 0xf3, 0x5e, 0x11, 0xb3, 0x2b, 0x78,
+	0x0e, 0x28, 0x2d, 0x77, 0x7d, 0xcb, 0x65, 0x25, 0xfa, 0xeb, 0x3b, 0x2e, 0x29, 0x1a, 0x2d, 0xbd,
+	0xd5, 0x8c, 0x85, 0x4e, 0xb9, 0xb4, 0x42, 0xca, 0x25, 0x21, 0x82, 0x7f, 0x22, 0xa1, 0x22, 0xdf,
+	0xea, 0x76, 0x36, 0x5c, 0x77, 0x57, 0xe9, 0x28, 0x70, 0x9c, 0x99, 0xe3, 0x1e, 0x60, 0x48, 0xc2,
+	0x39, 0x4c, 0x8b, 0xf4, 0x5b, 0x7a, 0xf8, 0x91, 0x86, 0x4f, 0x0c, 0xf8, 0x72, 0x28, 0xd2, 0x7c,
+	0xc7, 0x42, 0x0f, 0x67, 0x10, 0xec, 0xb7, 0xc5, 0x7e, 0x17, 0x8e, 0xf0, 0x19, 0xcc, 0xbe, 0x5e,
+	0x65, 0xf9, 0x61, 0xcf, 0xb6, 0x49, 0x38, 0x46, 0x84, 0x85, 0x55, 0x06, 0xce, 0x37, 0xab, 0x59,
+	0x91, 0x24, 0x5b, 0xf6, 0x33, 0x0c, 0xf0, 0x0c, 0xfc, 0xab, 0xf4, 0xf2, 0x10, 0x4e, 0xf0, 0x29,
+	0x9c, 0x65, 0xf9, 0x36, 0xdf, 0x65, 0xbb, 0x3c, 0x9c, 0xc6, 0x9f, 0x60, 0x92, 0x71, 0xa9, 0x04,
+	0xe1, 0x0b, 0x08, 0xfe, 0x70, 0xd1, 0xb9, 0x5a, 0x3c, 0xe6, 0x00, 0xbe, 0x84, 0x99, 0xae, 0x24,
+	0xb5, 0x9a, 0x4b, 0x65, 0xbf, 0x73, 0xcc, 0x06, 0x22, 0x26, 0x80, 0xbc, 0x92, 0x94, 0x51, 0x53,
+	0x51, 0x8b, 0xaf, 0x61, 0x22, 0x78, 0x49, 0xa2, 0x8d, 0xbc, 0xe5, 0x78, 0x35, 0xbf, 0x78, 0xfe,
+	0xb0, 0xd9, 0xef, 0x46, 0x61, 0xf7, 0x06, 0x3c, 0x87, 0x69, 0x6b, 0x63, 0xdb, 0x68, 0x64, 0xbd,
+	0xf8, 0xd0, 0xeb, 0x2e, 0x62, 0xff, 0x2d, 0xf1, 0x1b, 0x08, 0xec, 0xba, 0x29, 0xcf, 0x16, 0xee,
+	0xb9, 0xf2, 0xcc, 0x3c, 0xdc, 0xed, 0xfe, 0x82, 0x03, 0x9f, 0xdf, 0xff, 0x7a, 0x77, 0xac, 0xf4,
+	0xa9, 0x2b, 0xd7, 0x37, 0xb5, 0xdc, 0xa8, 0xba, 0xdf, 0x88, 0xfa, 0x78, 0x6c, 0xa8, 0xec, 0xb4,
+	0xa6, 0xe6, 0x56, 0xf4, 0x1b, 0xae, 0xaa, 0x8d, 0xc9, 0x54, 0xe5, 0xc7, 0x21, 0xba, 0x9c, 0xd8,
+	0x17, 0xf4, 0xf6, 0xdf, 0x00, 0x8e, 0x4a, 0x53, 0x53, 0x50, 0x02, 0x00, 0x00,
+}
@@ -8,6 +8,7 @@ Package loggrebutterfly is a generated protocol buffer package.
 It is generated from these files:
 	analyst.proto
 	data_node.proto
+	firehose.proto
 	master.proto
 
 It has these top-level messages:
@@ -15,6 +16,7 @@ It has these top-level messages:
 	AggregateInfo
 	QueryResponse
 	AggregateResponse
+	Warning
 	AnalystFilter
 	TimeRange
 	CounterFilter
@@ -23,14 +25,21 @@ It has these top-level messages:
 	GaugeFilterValue
 	WriteInfo
 	WriteResponse
+	Rejection
 	ReadInfo
 	ReadData
+	SubscribeInfo
 	RoutesInfo
 	RoutesResponse
 	RouteInfo
 	AnalystsInfo
 	AnalystsResponse
 	AnalystInfo
+	DecisionsInfo
+	DecisionsResponse
+	Decision
+	RangeMetric
+	DecisionThresholds
 */
 package loggrebutterfly
 
@@ -55,8 +64,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type QueryOrder int32
+
+const (
+	// TIMESTAMP orders envelopes by their timestamp. Envelopes with the same
+	// timestamp are ordered by their hybrid logical clock value.
+	QueryOrder_TIMESTAMP QueryOrder = 0
+	// HLC orders envelopes by the hybrid logical clock value stamped by the
+	// data nodes. It is a total order that agrees with causality.
+	QueryOrder_HLC QueryOrder = 1
+)
+
+var QueryOrder_name = map[int32]string{
+	0: "TIMESTAMP",
+	1: "HLC",
+}
+var QueryOrder_value = map[string]int32{
+	"TIMESTAMP": 0,
+	"HLC":       1,
+}
+
+func (x QueryOrder) String() string {
+	return proto.EnumName(QueryOrder_name, int32(x))
+}
+func (QueryOrder) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type TimestampSource int32
+
+const (
+	// PRODUCER is the timestamp set by the producer.
+	TimestampSource_PRODUCER TimestampSource = 0
+	// INGEST is the time the data node received the envelope. Envelopes
+	// without an ingest timestamp use the producer's timestamp.
+	TimestampSource_INGEST TimestampSource = 1
+)
+
+var TimestampSource_name = map[int32]string{
+	0: "PRODUCER",
+	1: "INGEST",
+}
+var TimestampSource_value = map[string]int32{
+	"PRODUCER": 0,
+	"INGEST":   1,
+}
+
+func (x TimestampSource) String() string {
+	return proto.EnumName(TimestampSource_name, int32(x))
+}
+func (TimestampSource) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
 type QueryInfo struct {
 	Filter *AnalystFilter `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	Order  QueryOrder     `protobuf:"varint,2,opt,name=order,enum=loggrebutterfly.QueryOrder" json:"order,omitempty"`
+	// best_effort returns the results of the files that succeeded when a
+	// file fails on every replica. The failed files are listed in the
+	// response's warnings. Otherwise the request fails.
+	BestEffort bool `protobuf:"varint,3,opt,name=best_effort,json=bestEffort" json:"best_effort,omitempty"`
 }
 
 func (m *QueryInfo) Reset()                    { *m = QueryInfo{} }
@@ -71,6 +134,20 @@ func (m *QueryInfo) GetFilter() *AnalystFilter {
 	return nil
 }
 
+func (m *QueryInfo) GetOrder() QueryOrder {
+	if m != nil {
+		return m.Order
+	}
+	return QueryOrder_TIMESTAMP
+}
+
+func (m *QueryInfo) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
 type AggregateInfo struct {
 	Query         *QueryInfo `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
 	BucketWidthNs int64      `protobuf:"varint,2,opt,name=bucket_width_ns,json=bucketWidthNs" json:"bucket_width_ns,omitempty"`
@@ -97,6 +174,10 @@ func (m *AggregateInfo) GetBucketWidthNs() int64 {
 
 type QueryResponse struct {
 	Envelopes []*loggregator_v2.Envelope `protobuf:"bytes,1,rep,name=envelopes" json:"envelopes,omitempty"`
+	// partial is set when a best effort query is missing files. The
+	// warnings list the files and why they failed.
+	Partial  bool       `protobuf:"varint,2,opt,name=partial" json:"partial,omitempty"`
+	Warnings []*Warning `protobuf:"bytes,3,rep,name=warnings" json:"warnings,omitempty"`
 }
 
 func (m *QueryResponse) Reset()                    { *m = QueryResponse{} }
@@ -111,8 +192,26 @@ func (m *QueryResponse) GetEnvelopes() []*loggregator_v2.Envelope {
 	return nil
 }
 
+func (m *QueryResponse) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+func (m *QueryResponse) GetWarnings() []*Warning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type AggregateResponse struct {
 	Results map[int64]float64 `protobuf:"bytes,1,rep,name=results" json:"results,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// partial is set when a best effort aggregation is missing files. The
+	// warnings list the files and why they failed.
+	Partial  bool       `protobuf:"varint,2,opt,name=partial" json:"partial,omitempty"`
+	Warnings []*Warning `protobuf:"bytes,3,rep,name=warnings" json:"warnings,omitempty"`
 }
 
 func (m *AggregateResponse) Reset()                    { *m = AggregateResponse{} }
@@ -127,6 +226,45 @@ func (m *AggregateResponse) GetResults() map[int64]float64 {
 	return nil
 }
 
+func (m *AggregateResponse) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+func (m *AggregateResponse) GetWarnings() []*Warning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+// Warning describes a file that is missing from a best effort result.
+type Warning struct {
+	File   string `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *Warning) Reset()                    { *m = Warning{} }
+func (m *Warning) String() string            { return proto.CompactTextString(m) }
+func (*Warning) ProtoMessage()               {}
+func (*Warning) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Warning) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *Warning) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type AnalystFilter struct {
 	SourceId  string     `protobuf:"bytes,1,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
 	TimeRange *TimeRange `protobuf:"bytes,2,opt,name=time_range,json=timeRange" json:"time_range,omitempty"`
@@ -135,12 +273,18 @@ type AnalystFilter struct {
 	//	*AnalystFilter_Log
 	//	*AnalystFilter_Gauge
 	Envelopes isAnalystFilter_Envelopes `protobuf_oneof:"Envelopes"`
+	// timestamp_source selects the timestamp used for the time range and
+	// for buckets.
+	TimestampSource TimestampSource `protobuf:"varint,6,opt,name=timestamp_source,json=timestampSource,enum=loggrebutterfly.TimestampSource" json:"timestamp_source,omitempty"`
+	// tags keeps envelopes that have every tag with the given value. Integer
+	// and decimal tags are compared by their string form.
+	Tags map[string]string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *AnalystFilter) Reset()                    { *m = AnalystFilter{} }
 func (m *AnalystFilter) String() string            { return proto.CompactTextString(m) }
 func (*AnalystFilter) ProtoMessage()               {}
-func (*AnalystFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*AnalystFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 type isAnalystFilter_Envelopes interface {
 	isAnalystFilter_Envelopes()
@@ -202,6 +346,20 @@ func (m *AnalystFilter) GetGauge() *GaugeFilter {
 	return nil
 }
 
+func (m *AnalystFilter) GetTimestampSource() TimestampSource {
+	if m != nil {
+		return m.TimestampSource
+	}
+	return TimestampSource_PRODUCER
+}
+
+func (m *AnalystFilter) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*AnalystFilter) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _AnalystFilter_OneofMarshaler, _AnalystFilter_OneofUnmarshaler, _AnalystFilter_OneofSizer, []interface{}{
@@ -304,7 +462,7 @@ type TimeRange struct {
 func (m *TimeRange) Reset()                    { *m = TimeRange{} }
 func (m *TimeRange) String() string            { return proto.CompactTextString(m) }
 func (*TimeRange) ProtoMessage()               {}
-func (*TimeRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*TimeRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *TimeRange) GetStart() int64 {
 	if m != nil {
@@ -327,7 +485,7 @@ type CounterFilter struct {
 func (m *CounterFilter) Reset()                    { *m = CounterFilter{} }
 func (m *CounterFilter) String() string            { return proto.CompactTextString(m) }
 func (*CounterFilter) ProtoMessage()               {}
-func (*CounterFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*CounterFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *CounterFilter) GetName() string {
 	if m != nil {
@@ -346,7 +504,7 @@ type LogFilter struct {
 func (m *LogFilter) Reset()                    { *m = LogFilter{} }
 func (m *LogFilter) String() string            { return proto.CompactTextString(m) }
 func (*LogFilter) ProtoMessage()               {}
-func (*LogFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*LogFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 type isLogFilter_Payload interface {
 	isLogFilter_Payload()
@@ -457,7 +615,7 @@ type GaugeFilter struct {
 func (m *GaugeFilter) Reset()                    { *m = GaugeFilter{} }
 func (m *GaugeFilter) String() string            { return proto.CompactTextString(m) }
 func (*GaugeFilter) ProtoMessage()               {}
-func (*GaugeFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*GaugeFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *GaugeFilter) GetName() string {
 	if m != nil {
@@ -480,7 +638,7 @@ type GaugeFilterValue struct {
 func (m *GaugeFilterValue) Reset()                    { *m = GaugeFilterValue{} }
 func (m *GaugeFilterValue) String() string            { return proto.CompactTextString(m) }
 func (*GaugeFilterValue) ProtoMessage()               {}
-func (*GaugeFilterValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*GaugeFilterValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *GaugeFilterValue) GetValue() float64 {
 	if m != nil {
@@ -494,12 +652,15 @@ func init() {
 	proto.RegisterType((*AggregateInfo)(nil), "loggrebutterfly.AggregateInfo")
 	proto.RegisterType((*QueryResponse)(nil), "loggrebutterfly.QueryResponse")
 	proto.RegisterType((*AggregateResponse)(nil), "loggrebutterfly.AggregateResponse")
+	proto.RegisterType((*Warning)(nil), "loggrebutterfly.Warning")
 	proto.RegisterType((*AnalystFilter)(nil), "loggrebutterfly.AnalystFilter")
 	proto.RegisterType((*TimeRange)(nil), "loggrebutterfly.TimeRange")
 	proto.RegisterType((*CounterFilter)(nil), "loggrebutterfly.CounterFilter")
 	proto.RegisterType((*LogFilter)(nil), "loggrebutterfly.LogFilter")
 	proto.RegisterType((*GaugeFilter)(nil), "loggrebutterfly.GaugeFilter")
 	proto.RegisterType((*GaugeFilterValue)(nil), "loggrebutterfly.GaugeFilterValue")
+	proto.RegisterEnum("loggrebutterfly.QueryOrder", QueryOrder_name, QueryOrder_value)
+	proto.RegisterEnum("loggrebutterfly.TimestampSource", TimestampSource_name, TimestampSource_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -610,44 +771,58 @@ var _Analyst_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("analyst.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x93, 0xa6, 0xf9, 0x7c, 0xd3, 0xa8, 0xfd, 0x46, 0x08, 0x59, 0x01, 0x55, 0xc5, 0x48,
-	0x28, 0x2b, 0x07, 0xa5, 0xa8, 0x40, 0x57, 0xb4, 0x55, 0xfa, 0x23, 0x21, 0x44, 0x47, 0x08, 0x36,
-	0x48, 0xd1, 0xc4, 0xbe, 0x75, 0xad, 0x3a, 0x1e, 0x33, 0x1e, 0x07, 0xfc, 0x22, 0x2c, 0xd8, 0xf2,
-	0x24, 0xbc, 0x19, 0x9a, 0x19, 0xdb, 0x75, 0xd3, 0x1f, 0x56, 0x99, 0x99, 0x7b, 0xce, 0xb9, 0x3f,
-	0x3e, 0x37, 0x30, 0x60, 0x09, 0x8b, 0x8b, 0x4c, 0x7a, 0xa9, 0xe0, 0x92, 0x93, 0xcd, 0x98, 0x87,
-	0xa1, 0xc0, 0x79, 0x2e, 0x25, 0x8a, 0x8b, 0xb8, 0x18, 0x1e, 0x87, 0x91, 0xbc, 0xcc, 0xe7, 0x9e,
-	0xcf, 0x17, 0x63, 0x96, 0xf2, 0x22, 0xc0, 0xc4, 0xc7, 0xf1, 0x0a, 0x6a, 0xcc, 0xd2, 0xa8, 0x7c,
-	0x0b, 0x99, 0xe4, 0x62, 0xbc, 0x9c, 0x8c, 0x31, 0x59, 0x62, 0xcc, 0x53, 0x34, 0xc2, 0xee, 0x11,
-	0xd8, 0xe7, 0x39, 0x8a, 0xe2, 0x2c, 0xb9, 0xe0, 0x64, 0x0f, 0xd6, 0x2f, 0xa2, 0x58, 0xa2, 0x70,
-	0xac, 0x1d, 0x6b, 0xd4, 0x9f, 0x6c, 0x7b, 0x2b, 0x82, 0xde, 0x81, 0xa9, 0xea, 0x58, 0xa3, 0x68,
-	0x89, 0x76, 0x23, 0x18, 0x1c, 0x94, 0x49, 0x50, 0x0b, 0xbd, 0x84, 0xee, 0x37, 0xa5, 0x5a, 0xea,
-	0x0c, 0x6f, 0xe9, 0xd4, 0x39, 0xa9, 0x01, 0x92, 0x17, 0xb0, 0x39, 0xcf, 0xfd, 0x2b, 0x94, 0xb3,
-	0xef, 0x51, 0x20, 0x2f, 0x67, 0x49, 0xe6, 0xb4, 0x77, 0xac, 0x51, 0x87, 0x0e, 0xcc, 0xf3, 0x17,
-	0xf5, 0xfa, 0x21, 0x73, 0x4f, 0x60, 0xa0, 0xb9, 0x14, 0xb3, 0x94, 0x27, 0x19, 0x92, 0x3d, 0xb0,
-	0xab, 0x96, 0x32, 0xc7, 0xda, 0xe9, 0x8c, 0xfa, 0x13, 0xc7, 0x6b, 0xf4, 0xec, 0x2d, 0x27, 0xde,
-	0xb4, 0x04, 0xd0, 0x6b, 0xa8, 0xfb, 0xcb, 0x82, 0xff, 0xeb, 0xa2, 0x6b, 0xb5, 0x33, 0xe8, 0x09,
-	0xcc, 0xf2, 0x58, 0x56, 0x5a, 0xe3, 0xdb, 0x23, 0x58, 0x25, 0x79, 0xd4, 0x30, 0xa6, 0x89, 0x14,
-	0x05, 0xad, 0xf8, 0xc3, 0x7d, 0xd8, 0x68, 0x06, 0xc8, 0x16, 0x74, 0xae, 0xd0, 0x4c, 0xa4, 0x43,
-	0xd5, 0x91, 0x3c, 0x82, 0xee, 0x92, 0xc5, 0x39, 0xea, 0x4e, 0x2d, 0x6a, 0x2e, 0xfb, 0xed, 0x37,
-	0x96, 0xfb, 0xb3, 0x0d, 0x83, 0x1b, 0xa3, 0x26, 0x4f, 0xc0, 0xce, 0x78, 0x2e, 0x7c, 0x9c, 0x45,
-	0x81, 0xd6, 0xb0, 0xe9, 0x7f, 0xe6, 0xe1, 0x2c, 0x20, 0x6f, 0x01, 0x64, 0xb4, 0xc0, 0x99, 0x60,
-	0x49, 0x68, 0xd4, 0xee, 0x9a, 0xf9, 0xa7, 0x68, 0x81, 0x54, 0x21, 0xa8, 0x2d, 0xab, 0x23, 0xd9,
-	0x87, 0x9e, 0xcf, 0xf3, 0x44, 0x7d, 0xf3, 0xce, 0x3d, 0xdf, 0xfc, 0xc8, 0xc4, 0x4d, 0x21, 0xa7,
-	0x2d, 0x5a, 0x11, 0x88, 0x07, 0x9d, 0x98, 0x87, 0xce, 0xda, 0x3d, 0xf9, 0xde, 0xf3, 0xb0, 0xe6,
-	0x28, 0x20, 0x79, 0x05, 0xdd, 0x90, 0xe5, 0x21, 0x3a, 0x5d, 0xcd, 0x78, 0x7a, 0x8b, 0x71, 0xa2,
-	0xa2, 0x35, 0xc7, 0x80, 0x0f, 0xfb, 0x60, 0x4f, 0xeb, 0xaf, 0xb6, 0x0b, 0x76, 0xdd, 0x86, 0x9a,
-	0x5f, 0x26, 0x99, 0x90, 0xe5, 0x4c, 0xcd, 0x45, 0xcd, 0x19, 0x93, 0xa0, 0x74, 0x8f, 0x3a, 0xba,
-	0xcf, 0x61, 0x70, 0xa3, 0x07, 0x42, 0x60, 0x2d, 0x61, 0x0b, 0x2c, 0xe7, 0xa8, 0xcf, 0xee, 0x29,
-	0xd8, 0x75, 0xc1, 0xc4, 0x81, 0x75, 0x81, 0x21, 0xfe, 0x48, 0x0d, 0xe4, 0xb4, 0x45, 0xcb, 0x3b,
-	0x79, 0x0c, 0xdd, 0x05, 0x93, 0xfe, 0xa5, 0xd6, 0xdf, 0x50, 0x55, 0xea, 0xeb, 0xa1, 0x0d, 0xbd,
-	0x8f, 0xac, 0x88, 0x39, 0x0b, 0xdc, 0x3f, 0x16, 0xf4, 0x1b, 0x9d, 0xdc, 0x95, 0x8d, 0xbc, 0xab,
-	0x37, 0xad, 0xad, 0x6d, 0x36, 0x7a, 0x68, 0x16, 0x9e, 0xf9, 0x31, 0xfe, 0x2a, 0x79, 0xc3, 0xaf,
-	0xd0, 0x6f, 0x3c, 0x37, 0xdd, 0x65, 0x1b, 0x77, 0xbd, 0x6e, 0xba, 0xab, 0x3f, 0x79, 0xf6, 0x50,
-	0x86, 0xcf, 0x0a, 0xd8, 0x34, 0xe0, 0x08, 0xb6, 0x56, 0xc3, 0xd7, 0x76, 0xb5, 0x1a, 0x76, 0x9d,
-	0xfc, 0xb6, 0xa0, 0x57, 0x5a, 0x95, 0x4c, 0xa1, 0xab, 0x97, 0x93, 0x3c, 0xb0, 0xf0, 0xc3, 0xed,
-	0xbb, 0x63, 0xd5, 0x36, 0xb9, 0x2d, 0x72, 0x0e, 0x76, 0xbd, 0x64, 0x64, 0xfb, 0xfe, 0x05, 0xd4,
-	0x72, 0xee, 0xbf, 0x17, 0xd4, 0x6d, 0xcd, 0xd7, 0xf5, 0xbf, 0xdd, 0xee, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x50, 0xb7, 0xa8, 0x88, 0x57, 0x05, 0x00, 0x00,
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x55, 0xef, 0x8e, 0xdb, 0x44,
+	0x10, 0x8f, 0xcf, 0x97, 0x38, 0x9e, 0x5c, 0xb8, 0xb0, 0x42, 0x95, 0x95, 0xa2, 0x23, 0x18, 0x84,
+	0xa2, 0x22, 0x39, 0x90, 0x96, 0x16, 0x4e, 0x7c, 0xe8, 0xf5, 0x08, 0x4d, 0x44, 0xff, 0x5c, 0xf7,
+	0x02, 0xfd, 0x82, 0x14, 0x6d, 0x92, 0x8d, 0xcf, 0xaa, 0xe3, 0x35, 0xeb, 0x75, 0x4a, 0x9e, 0x84,
+	0x07, 0xe0, 0x49, 0x78, 0x0d, 0x1e, 0x81, 0xa7, 0x40, 0xbb, 0x6b, 0x3b, 0x4e, 0x2e, 0x39, 0xbe,
+	0xf0, 0x29, 0xbb, 0xb3, 0xbf, 0xdf, 0xcc, 0x78, 0xe6, 0x37, 0x13, 0x68, 0x92, 0x88, 0x84, 0xeb,
+	0x44, 0x78, 0x31, 0x67, 0x82, 0xa1, 0xd3, 0x90, 0xf9, 0x3e, 0xa7, 0xd3, 0x54, 0x08, 0xca, 0x17,
+	0xe1, 0xba, 0xfd, 0xd4, 0x0f, 0xc4, 0x4d, 0x3a, 0xf5, 0x66, 0x6c, 0xd9, 0x8b, 0xd9, 0xba, 0xb7,
+	0xf3, 0xde, 0x23, 0x71, 0x90, 0xd9, 0x7c, 0x22, 0x18, 0xef, 0xad, 0xfa, 0x3d, 0x1a, 0xad, 0x68,
+	0xc8, 0x62, 0xaa, 0x5d, 0xba, 0x7f, 0x18, 0x60, 0xbf, 0x49, 0x29, 0x5f, 0x8f, 0xa2, 0x05, 0x43,
+	0x8f, 0xa1, 0xb6, 0x08, 0x42, 0x41, 0xb9, 0x63, 0x74, 0x8c, 0x6e, 0xa3, 0x7f, 0xe6, 0xed, 0x78,
+	0xf4, 0x2e, 0x74, 0x42, 0x3f, 0x2a, 0x14, 0xce, 0xd0, 0xe8, 0x6b, 0xa8, 0x32, 0x3e, 0xa7, 0xdc,
+	0x39, 0xea, 0x18, 0xdd, 0x0f, 0xfa, 0xf7, 0x6f, 0xd1, 0x54, 0x88, 0xd7, 0x12, 0x82, 0x35, 0x12,
+	0x7d, 0x02, 0x8d, 0x29, 0x4d, 0xc4, 0x84, 0x2e, 0x16, 0x8c, 0x0b, 0xc7, 0xec, 0x18, 0xdd, 0x3a,
+	0x06, 0x69, 0x1a, 0x28, 0x8b, 0x1b, 0x40, 0xf3, 0x22, 0xcb, 0x9c, 0xaa, 0xe4, 0xbe, 0x82, 0xea,
+	0x6f, 0xd2, 0x4d, 0x96, 0x5b, 0x7b, 0x7f, 0x10, 0x09, 0xc5, 0x1a, 0x88, 0xbe, 0x80, 0xd3, 0x69,
+	0x3a, 0x7b, 0x47, 0xc5, 0xe4, 0x7d, 0x30, 0x17, 0x37, 0x93, 0x28, 0x51, 0x09, 0x9a, 0xb8, 0xa9,
+	0xcd, 0x6f, 0xa5, 0xf5, 0x55, 0x22, 0x8b, 0xd0, 0x54, 0x64, 0x4c, 0x93, 0x98, 0x45, 0x09, 0x45,
+	0x8f, 0xc1, 0xce, 0x0b, 0x95, 0x38, 0x46, 0xc7, 0xec, 0x36, 0xfa, 0x8e, 0x57, 0xaa, 0xa4, 0xb7,
+	0xea, 0x7b, 0x83, 0x0c, 0x80, 0x37, 0x50, 0xe4, 0x80, 0x15, 0x13, 0x2e, 0x02, 0x12, 0xaa, 0x48,
+	0x75, 0x9c, 0x5f, 0xd1, 0x23, 0xa8, 0xbf, 0x27, 0x3c, 0x0a, 0x22, 0x3f, 0x71, 0xcc, 0x2d, 0x87,
+	0x9b, 0x0f, 0x78, 0xab, 0x01, 0xb8, 0x40, 0xba, 0xff, 0x18, 0xf0, 0x61, 0x51, 0x85, 0x22, 0xbb,
+	0x11, 0x58, 0x9c, 0x26, 0x69, 0x28, 0xf2, 0xdc, 0x7a, 0xb7, 0xfb, 0xb4, 0x4b, 0xf2, 0xb0, 0x66,
+	0x0c, 0x22, 0xc1, 0xd7, 0x38, 0xe7, 0xff, 0xdf, 0x09, 0xb7, 0xcf, 0xe1, 0xa4, 0x1c, 0x08, 0xb5,
+	0xc0, 0x7c, 0x47, 0x75, 0xcb, 0x4c, 0x2c, 0x8f, 0xe8, 0x23, 0xa8, 0xae, 0x48, 0x98, 0x52, 0x15,
+	0xcf, 0xc0, 0xfa, 0x72, 0x7e, 0xf4, 0xad, 0xe1, 0x7e, 0x03, 0x56, 0xe6, 0x10, 0x21, 0x38, 0x5e,
+	0x04, 0x21, 0x55, 0x3c, 0x1b, 0xab, 0x33, 0xba, 0x07, 0x35, 0x4e, 0x49, 0xc2, 0x22, 0xc5, 0xb4,
+	0x71, 0x76, 0x73, 0xff, 0x36, 0xa1, 0xb9, 0x25, 0x4b, 0x74, 0x1f, 0xec, 0x84, 0xa5, 0x7c, 0x46,
+	0x27, 0xc1, 0x3c, 0x73, 0x51, 0xd7, 0x86, 0xd1, 0x1c, 0x7d, 0x07, 0x20, 0x82, 0x25, 0x9d, 0x70,
+	0x12, 0xf9, 0x3a, 0x89, 0x7d, 0x5a, 0x1a, 0x07, 0x4b, 0x8a, 0x25, 0x02, 0xdb, 0x22, 0x3f, 0xa2,
+	0x73, 0xb0, 0x66, 0x2c, 0x8d, 0xe4, 0x7c, 0x98, 0x07, 0xe6, 0xe3, 0x52, 0xbf, 0xeb, 0x44, 0x86,
+	0x15, 0x9c, 0x13, 0x90, 0x07, 0x66, 0xc8, 0x7c, 0xe7, 0xf8, 0x40, 0xbc, 0x17, 0xcc, 0x2f, 0x38,
+	0x12, 0x88, 0x1e, 0x41, 0xd5, 0x27, 0xa9, 0x4f, 0x9d, 0xaa, 0x62, 0x7c, 0x7c, 0x8b, 0xf1, 0x5c,
+	0xbe, 0x16, 0x1c, 0x0d, 0x46, 0x3f, 0x41, 0x4b, 0xa6, 0x9b, 0x08, 0xb2, 0x8c, 0x27, 0xfa, 0x93,
+	0x9d, 0x9a, 0x9a, 0xc9, 0xce, 0xde, 0x4f, 0x54, 0xc0, 0x6b, 0x85, 0xc3, 0xa7, 0x62, 0xdb, 0x80,
+	0xbe, 0x87, 0x63, 0x41, 0xfc, 0xc4, 0xb1, 0x54, 0xf7, 0xbb, 0x77, 0xef, 0x02, 0x6f, 0x4c, 0xfc,
+	0x4c, 0x5c, 0x8a, 0xd5, 0x7e, 0x02, 0x76, 0x61, 0x2a, 0xcb, 0xc0, 0xde, 0x23, 0x03, 0xbb, 0x24,
+	0x83, 0x67, 0x0d, 0xb0, 0xf3, 0xd1, 0x4a, 0xdc, 0x87, 0x60, 0x17, 0xad, 0x90, 0x9c, 0x44, 0x10,
+	0x2e, 0x32, 0x39, 0xe9, 0x8b, 0xf4, 0x4d, 0xa3, 0x79, 0x36, 0xd9, 0xf2, 0xe8, 0x7e, 0x06, 0xcd,
+	0xad, 0x3e, 0x48, 0x39, 0x45, 0x64, 0x59, 0xc8, 0x49, 0x9e, 0xdd, 0x21, 0xd8, 0x45, 0xd1, 0x91,
+	0x23, 0xb5, 0xe5, 0xd3, 0xdf, 0x63, 0x0d, 0x19, 0x56, 0x70, 0x76, 0x47, 0xf7, 0xa0, 0xba, 0x24,
+	0x62, 0x76, 0xa3, 0xfc, 0x9f, 0xc8, 0x4a, 0xab, 0xeb, 0x33, 0x1b, 0xac, 0x2b, 0xb2, 0x0e, 0x19,
+	0x99, 0xbb, 0x7f, 0x19, 0xd0, 0x28, 0x75, 0x63, 0x5f, 0x34, 0xf4, 0xb4, 0xd8, 0xac, 0x47, 0x07,
+	0xaa, 0x59, 0xf2, 0xe0, 0xe9, 0x1f, 0x5d, 0xcd, 0x8c, 0xd7, 0xfe, 0x15, 0x1a, 0x25, 0xf3, 0x9e,
+	0x8a, 0x3e, 0x29, 0x57, 0xb4, 0xd1, 0xff, 0xf4, 0xae, 0x08, 0xbf, 0x48, 0x60, 0x79, 0xf6, 0xba,
+	0xd0, 0xda, 0x7d, 0xde, 0xb4, 0xc8, 0x28, 0x4d, 0xea, 0x83, 0xcf, 0x01, 0x36, 0xdb, 0x1c, 0x35,
+	0xc1, 0x1e, 0x8f, 0x5e, 0x0e, 0xae, 0xc7, 0x17, 0x2f, 0xaf, 0x5a, 0x15, 0x64, 0x81, 0x39, 0x7c,
+	0x71, 0xd9, 0x32, 0x1e, 0x7c, 0x09, 0xa7, 0x3b, 0xfa, 0x42, 0x27, 0x50, 0xbf, 0xc2, 0xaf, 0x7f,
+	0xf8, 0xf9, 0x72, 0x80, 0x5b, 0x15, 0x04, 0x50, 0x1b, 0xbd, 0x7a, 0x3e, 0xb8, 0x1e, 0xb7, 0x8c,
+	0xfe, 0x9f, 0x06, 0x58, 0x99, 0x98, 0xd0, 0x00, 0xaa, 0xca, 0x3d, 0xba, 0x63, 0xbf, 0xb7, 0xcf,
+	0xf6, 0xbf, 0xe5, 0xbb, 0xce, 0xad, 0xa0, 0x37, 0x60, 0x17, 0x2b, 0x10, 0x9d, 0x1d, 0x5e, 0x8f,
+	0xca, 0x9d, 0xfb, 0xdf, 0xeb, 0xd3, 0xad, 0x4c, 0x6b, 0xea, 0x1f, 0xf3, 0xe1, 0xbf, 0x03, 0x00,
+	0x22, 0x72, 0x56, 0xe5, 0x95, 0x07, 0x00, 0x00,
 }
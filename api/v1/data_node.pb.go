@@ -18,8 +18,44 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type RejectionCode int32
+
+const (
+	// WRITE_FAILED is a failure to write to talaria. The envelope may be
+	// retried.
+	RejectionCode_WRITE_FAILED RejectionCode = 0
+	// INVALID envelopes are kept in the data node's dead-letter buffer.
+	RejectionCode_INVALID RejectionCode = 1
+	// OVER_QUOTA envelopes were dropped because the source is over its rate
+	// limit.
+	RejectionCode_OVER_QUOTA RejectionCode = 2
+)
+
+var RejectionCode_name = map[int32]string{
+	0: "WRITE_FAILED",
+	1: "INVALID",
+	2: "OVER_QUOTA",
+}
+var RejectionCode_value = map[string]int32{
+	"WRITE_FAILED": 0,
+	"INVALID":      1,
+	"OVER_QUOTA":   2,
+}
+
+func (x RejectionCode) String() string {
+	return proto.EnumName(RejectionCode_name, int32(x))
+}
+func (RejectionCode) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
 type WriteInfo struct {
 	Payload []byte `protobuf:"bytes,1,opt,name=Payload,json=payload,proto3" json:"Payload,omitempty"`
+	// sequence identifies the first envelope in acks and rejections. Each
+	// following envelope has the next sequence. If it is not set, the
+	// position in the stream (starting at 1) is used.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence" json:"sequence,omitempty"`
+	// payloads are written after Payload. Batching envelopes avoids the
+	// per-message overhead of the stream.
+	Payloads [][]byte `protobuf:"bytes,3,rep,name=payloads,proto3" json:"payloads,omitempty"`
 }
 
 func (m *WriteInfo) Reset()                    { *m = WriteInfo{} }
@@ -34,7 +70,29 @@ func (m *WriteInfo) GetPayload() []byte {
 	return nil
 }
 
+func (m *WriteInfo) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *WriteInfo) GetPayloads() [][]byte {
+	if m != nil {
+		return m.Payloads
+	}
+	return nil
+}
+
 type WriteResponse struct {
+	// ack is cumulative. Every envelope up to and including this sequence
+	// has been accepted by talaria or rejected.
+	Ack        uint64       `protobuf:"varint,1,opt,name=ack" json:"ack,omitempty"`
+	Rejections []*Rejection `protobuf:"bytes,2,rep,name=rejections" json:"rejections,omitempty"`
+	// deduplicated is set when the data node drops envelopes it already
+	// wrote. Only then can envelopes that were not acknowledged be written
+	// again without duplicating them.
+	Deduplicated bool `protobuf:"varint,3,opt,name=deduplicated" json:"deduplicated,omitempty"`
 }
 
 func (m *WriteResponse) Reset()                    { *m = WriteResponse{} }
@@ -42,15 +100,89 @@ func (m *WriteResponse) String() string            { return proto.CompactTextStr
 func (*WriteResponse) ProtoMessage()               {}
 func (*WriteResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
 
+func (m *WriteResponse) GetAck() uint64 {
+	if m != nil {
+		return m.Ack
+	}
+	return 0
+}
+
+func (m *WriteResponse) GetRejections() []*Rejection {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+func (m *WriteResponse) GetDeduplicated() bool {
+	if m != nil {
+		return m.Deduplicated
+	}
+	return false
+}
+
+type Rejection struct {
+	Sequence uint64        `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
+	Reason   string        `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	Code     RejectionCode `protobuf:"varint,3,opt,name=code,enum=loggrebutterfly.RejectionCode" json:"code,omitempty"`
+	// source_id and retry_after_ns are set for OVER_QUOTA rejections. The
+	// source should not write again before retry_after_ns has passed.
+	SourceId     string `protobuf:"bytes,4,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
+	RetryAfterNs int64  `protobuf:"varint,5,opt,name=retry_after_ns,json=retryAfterNs" json:"retry_after_ns,omitempty"`
+}
+
+func (m *Rejection) Reset()                    { *m = Rejection{} }
+func (m *Rejection) String() string            { return proto.CompactTextString(m) }
+func (*Rejection) ProtoMessage()               {}
+func (*Rejection) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
+
+func (m *Rejection) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Rejection) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Rejection) GetCode() RejectionCode {
+	if m != nil {
+		return m.Code
+	}
+	return RejectionCode_WRITE_FAILED
+}
+
+func (m *Rejection) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+func (m *Rejection) GetRetryAfterNs() int64 {
+	if m != nil {
+		return m.RetryAfterNs
+	}
+	return 0
+}
+
 type ReadInfo struct {
 	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Index uint64 `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+	// filter is applied by the data node before envelopes are sent. Skipped
+	// envelopes still advance the index. A nil filter sends every envelope.
+	Filter *AnalystFilter `protobuf:"bytes,3,opt,name=filter" json:"filter,omitempty"`
 }
 
 func (m *ReadInfo) Reset()                    { *m = ReadInfo{} }
 func (m *ReadInfo) String() string            { return proto.CompactTextString(m) }
 func (*ReadInfo) ProtoMessage()               {}
-func (*ReadInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
+func (*ReadInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
 
 func (m *ReadInfo) GetName() string {
 	if m != nil {
@@ -66,6 +198,13 @@ func (m *ReadInfo) GetIndex() uint64 {
 	return 0
 }
 
+func (m *ReadInfo) GetFilter() *AnalystFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 type ReadData struct {
 	Payload []byte `protobuf:"bytes,1,opt,name=Payload,json=payload,proto3" json:"Payload,omitempty"`
 	File    string `protobuf:"bytes,2,opt,name=file" json:"file,omitempty"`
@@ -75,7 +214,7 @@ type ReadData struct {
 func (m *ReadData) Reset()                    { *m = ReadData{} }
 func (m *ReadData) String() string            { return proto.CompactTextString(m) }
 func (*ReadData) ProtoMessage()               {}
-func (*ReadData) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{3} }
+func (*ReadData) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
 
 func (m *ReadData) GetPayload() []byte {
 	if m != nil {
@@ -101,8 +240,10 @@ func (m *ReadData) GetIndex() uint64 {
 func init() {
 	proto.RegisterType((*WriteInfo)(nil), "loggrebutterfly.WriteInfo")
 	proto.RegisterType((*WriteResponse)(nil), "loggrebutterfly.WriteResponse")
+	proto.RegisterType((*Rejection)(nil), "loggrebutterfly.Rejection")
 	proto.RegisterType((*ReadInfo)(nil), "loggrebutterfly.ReadInfo")
 	proto.RegisterType((*ReadData)(nil), "loggrebutterfly.ReadData")
+	proto.RegisterEnum("loggrebutterfly.RejectionCode", RejectionCode_name, RejectionCode_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -139,7 +280,7 @@ func (c *dataNodeClient) Write(ctx context.Context, opts ...grpc.CallOption) (Da
 
 type DataNode_WriteClient interface {
 	Send(*WriteInfo) error
-	CloseAndRecv() (*WriteResponse, error)
+	Recv() (*WriteResponse, error)
 	grpc.ClientStream
 }
 
@@ -151,10 +292,7 @@ func (x *dataNodeWriteClient) Send(m *WriteInfo) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *dataNodeWriteClient) CloseAndRecv() (*WriteResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
+func (x *dataNodeWriteClient) Recv() (*WriteResponse, error) {
 	m := new(WriteResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -210,7 +348,7 @@ func _DataNode_Write_Handler(srv interface{}, stream grpc.ServerStream) error {
 }
 
 type DataNode_WriteServer interface {
-	SendAndClose(*WriteResponse) error
+	Send(*WriteResponse) error
 	Recv() (*WriteInfo, error)
 	grpc.ServerStream
 }
@@ -219,7 +357,7 @@ type dataNodeWriteServer struct {
 	grpc.ServerStream
 }
 
-func (x *dataNodeWriteServer) SendAndClose(m *WriteResponse) error {
+func (x *dataNodeWriteServer) Send(m *WriteResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -260,6 +398,7 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 		{
 			StreamName:    "Write",
 			Handler:       _DataNode_Write_Handler,
+			ServerStreams: true,
 			ClientStreams: true,
 		},
 		{
@@ -274,20 +413,36 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("data_node.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x49, 0x2c, 0x49,
-	0x8c, 0xcf, 0xcb, 0x4f, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcf, 0xc9, 0x4f,
-	0x4f, 0x2f, 0x4a, 0x4d, 0x2a, 0x2d, 0x29, 0x49, 0x2d, 0x4a, 0xcb, 0xa9, 0x54, 0x52, 0xe5, 0xe2,
-	0x0c, 0x2f, 0xca, 0x2c, 0x49, 0xf5, 0xcc, 0x4b, 0xcb, 0x17, 0x92, 0xe0, 0x62, 0x0f, 0x48, 0xac,
-	0xcc, 0xc9, 0x4f, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x62, 0x2f, 0x80, 0x70, 0x95,
-	0xf8, 0xb9, 0x78, 0xc1, 0xca, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x4c, 0xb8,
-	0x38, 0x82, 0x52, 0x13, 0x53, 0xc0, 0xda, 0x84, 0xb8, 0x58, 0xf2, 0x12, 0x73, 0x53, 0xc1, 0x7a,
-	0x38, 0x83, 0xc0, 0x6c, 0x21, 0x11, 0x2e, 0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x26, 0x05,
-	0x46, 0x0d, 0x96, 0x20, 0x08, 0x47, 0xc9, 0x0f, 0xa2, 0xcb, 0x25, 0xb1, 0x24, 0x11, 0xb7, 0x65,
-	0x20, 0xf3, 0xd2, 0x32, 0x73, 0x52, 0xc1, 0x5a, 0x39, 0x83, 0xc0, 0x6c, 0x84, 0x79, 0xcc, 0x48,
-	0xe6, 0x19, 0x4d, 0x65, 0xe4, 0xe2, 0x00, 0x19, 0xe6, 0x97, 0x9f, 0x92, 0x2a, 0xe4, 0xce, 0xc5,
-	0x0a, 0x76, 0xa3, 0x90, 0x94, 0x1e, 0x9a, 0x2f, 0xf5, 0xe0, 0x5e, 0x94, 0x92, 0xc3, 0x2e, 0x07,
-	0xf7, 0x17, 0x83, 0x06, 0xa3, 0x90, 0x03, 0x17, 0x0b, 0xc8, 0x95, 0x42, 0x92, 0x18, 0x6a, 0x61,
-	0x5e, 0x96, 0xc2, 0x2e, 0x05, 0x72, 0x8a, 0x12, 0x83, 0x01, 0x63, 0x12, 0x1b, 0x38, 0xb4, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x83, 0xd0, 0x7d, 0x80, 0x01, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x53, 0x4f, 0x6b, 0xdb, 0x4e,
+	0x10, 0xf5, 0x5a, 0xf2, 0xbf, 0xf1, 0x9f, 0x98, 0xe5, 0xc7, 0x0f, 0xd5, 0x85, 0x20, 0x44, 0x0f,
+	0xa2, 0x07, 0x13, 0x5c, 0xe8, 0xa1, 0x87, 0x52, 0x53, 0x3b, 0x20, 0x08, 0x4e, 0xbb, 0xa4, 0xc9,
+	0xa9, 0x88, 0x8d, 0x76, 0x1c, 0xd4, 0xaa, 0xbb, 0xee, 0xee, 0x1a, 0xea, 0x63, 0x3f, 0x45, 0xbf,
+	0x49, 0x3f, 0x5f, 0xd1, 0x4a, 0x71, 0xe3, 0xd4, 0xed, 0x6d, 0xde, 0xcc, 0xdb, 0xd1, 0x9b, 0x99,
+	0x27, 0x38, 0x11, 0xdc, 0xf2, 0x54, 0x2a, 0x81, 0xd3, 0x8d, 0x56, 0x56, 0xd1, 0x93, 0x42, 0xdd,
+	0xdd, 0x69, 0xbc, 0xdd, 0x5a, 0x8b, 0x7a, 0x5d, 0xec, 0x26, 0x43, 0x2e, 0x79, 0xb1, 0x33, 0xb6,
+	0xaa, 0x47, 0x1f, 0xa1, 0x77, 0xa3, 0x73, 0x8b, 0x89, 0x5c, 0x2b, 0x1a, 0x40, 0xe7, 0x1d, 0xdf,
+	0x15, 0x8a, 0x8b, 0x80, 0x84, 0x24, 0x1e, 0xb0, 0xce, 0xa6, 0x82, 0x74, 0x02, 0x5d, 0x83, 0x5f,
+	0xb7, 0x28, 0x33, 0x0c, 0x9a, 0x21, 0x89, 0x7d, 0xb6, 0xc7, 0x65, 0xad, 0xa6, 0x99, 0xc0, 0x0b,
+	0xbd, 0x78, 0xc0, 0xf6, 0x38, 0xfa, 0x4e, 0x60, 0xe8, 0xfa, 0x33, 0x34, 0x1b, 0x25, 0x0d, 0xd2,
+	0x31, 0x78, 0x3c, 0xfb, 0xec, 0xfa, 0xfb, 0xac, 0x0c, 0xe9, 0x2b, 0x00, 0x8d, 0x9f, 0x30, 0xb3,
+	0xb9, 0x92, 0x26, 0x68, 0x86, 0x5e, 0xdc, 0x9f, 0x4d, 0xa6, 0x8f, 0x74, 0x4f, 0xd9, 0x3d, 0x85,
+	0x3d, 0x60, 0xd3, 0x08, 0x06, 0x02, 0xc5, 0x76, 0x53, 0xe4, 0x19, 0xb7, 0x28, 0x02, 0x2f, 0x24,
+	0x71, 0x97, 0x1d, 0xe4, 0xa2, 0x9f, 0x04, 0x7a, 0xfb, 0xd7, 0x07, 0x93, 0x90, 0x47, 0x93, 0xfc,
+	0x0f, 0x6d, 0x8d, 0xdc, 0x28, 0xe9, 0x66, 0xec, 0xb1, 0x1a, 0xd1, 0x19, 0xf8, 0x99, 0x12, 0xe8,
+	0xba, 0x8f, 0x66, 0xa7, 0x7f, 0xd7, 0xf6, 0x56, 0x09, 0x64, 0x8e, 0x4b, 0x9f, 0x42, 0xcf, 0xa8,
+	0xad, 0xce, 0x30, 0xcd, 0x45, 0xe0, 0xbb, 0x76, 0xdd, 0x2a, 0x91, 0x08, 0xfa, 0x0c, 0x46, 0x1a,
+	0xad, 0xde, 0xa5, 0x7c, 0x6d, 0x51, 0xa7, 0xd2, 0x04, 0xad, 0x90, 0xc4, 0x1e, 0x1b, 0xb8, 0xec,
+	0xbc, 0x4c, 0xae, 0x4c, 0x54, 0x40, 0x97, 0x21, 0x17, 0xee, 0x34, 0x14, 0x7c, 0xc9, 0xbf, 0x54,
+	0x92, 0x7b, 0xcc, 0xc5, 0xf4, 0x3f, 0x68, 0xe5, 0x52, 0xe0, 0xb7, 0xfa, 0x22, 0x15, 0xa0, 0x2f,
+	0xa1, 0xbd, 0xce, 0x0b, 0x8b, 0xda, 0xc9, 0xed, 0x1f, 0x91, 0x3b, 0xaf, 0x1c, 0x70, 0xee, 0x58,
+	0xac, 0x66, 0x47, 0xab, 0xea, 0x6b, 0x0b, 0x6e, 0xf9, 0x3f, 0x8c, 0x40, 0xc1, 0x5f, 0xe7, 0x05,
+	0xd6, 0x0b, 0x72, 0xf1, 0x6f, 0x1d, 0xde, 0x03, 0x1d, 0xcf, 0x5f, 0xc3, 0xf0, 0x60, 0x2f, 0x74,
+	0x0c, 0x83, 0x1b, 0x96, 0x5c, 0x2d, 0xd3, 0xf3, 0x79, 0x72, 0xb1, 0x5c, 0x8c, 0x1b, 0xb4, 0x0f,
+	0x9d, 0x64, 0x75, 0x3d, 0xbf, 0x48, 0x16, 0x63, 0x42, 0x47, 0x00, 0x97, 0xd7, 0x4b, 0x96, 0xbe,
+	0xff, 0x70, 0x79, 0x35, 0x1f, 0x37, 0x67, 0x3f, 0x08, 0x74, 0x4b, 0x31, 0xab, 0xf2, 0x6d, 0x02,
+	0x2d, 0x67, 0x23, 0xfa, 0xa7, 0x31, 0xf6, 0xf6, 0x9d, 0x9c, 0x1e, 0xaf, 0xdd, 0x5b, 0x2f, 0x6a,
+	0xc4, 0xe4, 0x8c, 0xd0, 0x37, 0xe0, 0x97, 0x73, 0xd2, 0x27, 0x47, 0xce, 0x58, 0x2d, 0x7b, 0x72,
+	0xbc, 0x54, 0x8a, 0x89, 0x1a, 0x67, 0xe4, 0xb6, 0xed, 0x7e, 0x9d, 0x17, 0xbf, 0x06, 0x00, 0x3f,
+	0xa0, 0xe3, 0xeb, 0x6d, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-go.
+// source: firehose.proto
+// DO NOT EDIT!
+
+package loggrebutterfly
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type SubscribeInfo struct {
+	// group is shared by the consumers that split the ranges between them.
+	// Each range is sent to one member of the group and the ranges are
+	// reassigned when members join or leave. A consumer without a group
+	// receives every range.
+	Group string `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
+	// filter is applied by the data nodes. Without a time range, only the
+	// envelopes ingested after the group started are sent.
+	Filter *AnalystFilter `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *SubscribeInfo) Reset()                    { *m = SubscribeInfo{} }
+func (m *SubscribeInfo) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeInfo) ProtoMessage()               {}
+func (*SubscribeInfo) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+
+func (m *SubscribeInfo) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *SubscribeInfo) GetFilter() *AnalystFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeInfo)(nil), "loggrebutterfly.SubscribeInfo")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Firehose service
+
+type FirehoseClient interface {
+	Subscribe(ctx context.Context, in *SubscribeInfo, opts ...grpc.CallOption) (Firehose_SubscribeClient, error)
+}
+
+type firehoseClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFirehoseClient(cc *grpc.ClientConn) FirehoseClient {
+	return &firehoseClient{cc}
+}
+
+func (c *firehoseClient) Subscribe(ctx context.Context, in *SubscribeInfo, opts ...grpc.CallOption) (Firehose_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Firehose_serviceDesc.Streams[0], c.cc, "/loggrebutterfly.Firehose/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &firehoseSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Firehose_SubscribeClient interface {
+	Recv() (*ReadData, error)
+	grpc.ClientStream
+}
+
+type firehoseSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *firehoseSubscribeClient) Recv() (*ReadData, error) {
+	m := new(ReadData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Firehose service
+
+type FirehoseServer interface {
+	Subscribe(*SubscribeInfo, Firehose_SubscribeServer) error
+}
+
+func RegisterFirehoseServer(s *grpc.Server, srv FirehoseServer) {
+	s.RegisterService(&_Firehose_serviceDesc, srv)
+}
+
+func _Firehose_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FirehoseServer).Subscribe(m, &firehoseSubscribeServer{stream})
+}
+
+type Firehose_SubscribeServer interface {
+	Send(*ReadData) error
+	grpc.ServerStream
+}
+
+type firehoseSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *firehoseSubscribeServer) Send(m *ReadData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Firehose_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "loggrebutterfly.Firehose",
+	HandlerType: (*FirehoseServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Firehose_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "firehose.proto",
+}
+
+func init() { proto.RegisterFile("firehose.proto", fileDescriptor2) }
+
+var fileDescriptor2 = []byte{
+	// 183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x4b, 0xcb, 0x2c, 0x4a,
+	0xcd, 0xc8, 0x2f, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcf, 0xc9, 0x4f, 0x4f,
+	0x2f, 0x4a, 0x4d, 0x2a, 0x2d, 0x29, 0x49, 0x2d, 0x4a, 0xcb, 0xa9, 0x94, 0xe2, 0x4d, 0xcc, 0x4b,
+	0xcc, 0xa9, 0x2c, 0x2e, 0x81, 0xc8, 0x4b, 0xf1, 0xa7, 0x24, 0x96, 0x24, 0xc6, 0xe7, 0xe5, 0xa7,
+	0x40, 0x35, 0x28, 0xc5, 0x72, 0xf1, 0x06, 0x97, 0x26, 0x15, 0x27, 0x17, 0x65, 0x26, 0xa5, 0x7a,
+	0xe6, 0xa5, 0xe5, 0x0b, 0x89, 0x70, 0xb1, 0xa6, 0x17, 0xe5, 0x97, 0x16, 0x48, 0x30, 0x2a, 0x30,
+	0x6a, 0x70, 0x06, 0x41, 0x38, 0x42, 0x66, 0x5c, 0x6c, 0x69, 0x99, 0x39, 0x25, 0xa9, 0x45, 0x12,
+	0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a, 0x68, 0x16, 0xe9, 0x39, 0x42, 0xec, 0x71, 0x03,
+	0xab, 0x0a, 0x82, 0xaa, 0x36, 0x0a, 0xe3, 0xe2, 0x70, 0x83, 0xba, 0x50, 0xc8, 0x8b, 0x8b, 0x13,
+	0x6e, 0x95, 0x10, 0xa6, 0x01, 0x28, 0xce, 0x90, 0x92, 0xc4, 0x90, 0x0f, 0x4a, 0x4d, 0x4c, 0x71,
+	0x49, 0x2c, 0x49, 0x54, 0x62, 0x30, 0x60, 0x4c, 0x62, 0x03, 0xbb, 0xde, 0x18, 0x30, 0x00, 0xad,
+	0xc9, 0x19, 0xda, 0x00, 0x01, 0x00, 0x00,
+}
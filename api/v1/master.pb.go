@@ -24,7 +24,7 @@ type RoutesInfo struct {
 func (m *RoutesInfo) Reset()                    { *m = RoutesInfo{} }
 func (m *RoutesInfo) String() string            { return proto.CompactTextString(m) }
 func (*RoutesInfo) ProtoMessage()               {}
-func (*RoutesInfo) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*RoutesInfo) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 type RoutesResponse struct {
 	Routes []*RouteInfo `protobuf:"bytes,1,rep,name=routes" json:"routes,omitempty"`
@@ -33,7 +33,7 @@ type RoutesResponse struct {
 func (m *RoutesResponse) Reset()                    { *m = RoutesResponse{} }
 func (m *RoutesResponse) String() string            { return proto.CompactTextString(m) }
 func (*RoutesResponse) ProtoMessage()               {}
-func (*RoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+func (*RoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 func (m *RoutesResponse) GetRoutes() []*RouteInfo {
 	if m != nil {
@@ -50,7 +50,7 @@ type RouteInfo struct {
 func (m *RouteInfo) Reset()                    { *m = RouteInfo{} }
 func (m *RouteInfo) String() string            { return proto.CompactTextString(m) }
 func (*RouteInfo) ProtoMessage()               {}
-func (*RouteInfo) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+func (*RouteInfo) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
 
 func (m *RouteInfo) GetName() string {
 	if m != nil {
@@ -72,7 +72,7 @@ type AnalystsInfo struct {
 func (m *AnalystsInfo) Reset()                    { *m = AnalystsInfo{} }
 func (m *AnalystsInfo) String() string            { return proto.CompactTextString(m) }
 func (*AnalystsInfo) ProtoMessage()               {}
-func (*AnalystsInfo) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+func (*AnalystsInfo) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
 
 type AnalystsResponse struct {
 	Analysts []*AnalystInfo `protobuf:"bytes,1,rep,name=analysts" json:"analysts,omitempty"`
@@ -81,7 +81,7 @@ type AnalystsResponse struct {
 func (m *AnalystsResponse) Reset()                    { *m = AnalystsResponse{} }
 func (m *AnalystsResponse) String() string            { return proto.CompactTextString(m) }
 func (*AnalystsResponse) ProtoMessage()               {}
-func (*AnalystsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+func (*AnalystsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
 
 func (m *AnalystsResponse) GetAnalysts() []*AnalystInfo {
 	if m != nil {
@@ -97,7 +97,7 @@ type AnalystInfo struct {
 func (m *AnalystInfo) Reset()                    { *m = AnalystInfo{} }
 func (m *AnalystInfo) String() string            { return proto.CompactTextString(m) }
 func (*AnalystInfo) ProtoMessage()               {}
-func (*AnalystInfo) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+func (*AnalystInfo) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
 
 func (m *AnalystInfo) GetAddr() string {
 	if m != nil {
@@ -106,6 +106,177 @@ func (m *AnalystInfo) GetAddr() string {
 	return ""
 }
 
+type DecisionsInfo struct {
+	// Empty returns the decisions of every maintainer.
+	Maintainer string `protobuf:"bytes,1,opt,name=maintainer" json:"maintainer,omitempty"`
+	// Only decisions made at or after start (UnixNano) are returned.
+	Start int64 `protobuf:"varint,2,opt,name=start" json:"start,omitempty"`
+	// Zero returns every matching decision.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *DecisionsInfo) Reset()                    { *m = DecisionsInfo{} }
+func (m *DecisionsInfo) String() string            { return proto.CompactTextString(m) }
+func (*DecisionsInfo) ProtoMessage()               {}
+func (*DecisionsInfo) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
+
+func (m *DecisionsInfo) GetMaintainer() string {
+	if m != nil {
+		return m.Maintainer
+	}
+	return ""
+}
+
+func (m *DecisionsInfo) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *DecisionsInfo) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DecisionsResponse struct {
+	Decisions []*Decision `protobuf:"bytes,1,rep,name=decisions" json:"decisions,omitempty"`
+}
+
+func (m *DecisionsResponse) Reset()                    { *m = DecisionsResponse{} }
+func (m *DecisionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DecisionsResponse) ProtoMessage()               {}
+func (*DecisionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
+
+func (m *DecisionsResponse) GetDecisions() []*Decision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+type Decision struct {
+	Timestamp  int64                   `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	Maintainer string                  `protobuf:"bytes,2,opt,name=maintainer" json:"maintainer,omitempty"`
+	File       string                  `protobuf:"bytes,3,opt,name=file" json:"file,omitempty"`
+	DryRun     bool                    `protobuf:"varint,4,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	Error      string                  `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+	Metrics    map[string]*RangeMetric `protobuf:"bytes,6,rep,name=metrics" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Thresholds *DecisionThresholds     `protobuf:"bytes,7,opt,name=thresholds" json:"thresholds,omitempty"`
+}
+
+func (m *Decision) Reset()                    { *m = Decision{} }
+func (m *Decision) String() string            { return proto.CompactTextString(m) }
+func (*Decision) ProtoMessage()               {}
+func (*Decision) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+
+func (m *Decision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Decision) GetMaintainer() string {
+	if m != nil {
+		return m.Maintainer
+	}
+	return ""
+}
+
+func (m *Decision) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *Decision) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *Decision) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Decision) GetMetrics() map[string]*RangeMetric {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *Decision) GetThresholds() *DecisionThresholds {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+type RangeMetric struct {
+	WriteCount uint64 `protobuf:"varint,1,opt,name=write_count,json=writeCount" json:"write_count,omitempty"`
+	ErrCount   uint64 `protobuf:"varint,2,opt,name=err_count,json=errCount" json:"err_count,omitempty"`
+}
+
+func (m *RangeMetric) Reset()                    { *m = RangeMetric{} }
+func (m *RangeMetric) String() string            { return proto.CompactTextString(m) }
+func (*RangeMetric) ProtoMessage()               {}
+func (*RangeMetric) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+
+func (m *RangeMetric) GetWriteCount() uint64 {
+	if m != nil {
+		return m.WriteCount
+	}
+	return 0
+}
+
+func (m *RangeMetric) GetErrCount() uint64 {
+	if m != nil {
+		return m.ErrCount
+	}
+	return 0
+}
+
+type DecisionThresholds struct {
+	MinCount   uint64 `protobuf:"varint,1,opt,name=min_count,json=minCount" json:"min_count,omitempty"`
+	MaxCount   uint64 `protobuf:"varint,2,opt,name=max_count,json=maxCount" json:"max_count,omitempty"`
+	IntervalNs int64  `protobuf:"varint,3,opt,name=interval_ns,json=intervalNs" json:"interval_ns,omitempty"`
+}
+
+func (m *DecisionThresholds) Reset()                    { *m = DecisionThresholds{} }
+func (m *DecisionThresholds) String() string            { return proto.CompactTextString(m) }
+func (*DecisionThresholds) ProtoMessage()               {}
+func (*DecisionThresholds) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+
+func (m *DecisionThresholds) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *DecisionThresholds) GetMaxCount() uint64 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+func (m *DecisionThresholds) GetIntervalNs() int64 {
+	if m != nil {
+		return m.IntervalNs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RoutesInfo)(nil), "loggrebutterfly.RoutesInfo")
 	proto.RegisterType((*RoutesResponse)(nil), "loggrebutterfly.RoutesResponse")
@@ -113,6 +284,11 @@ func init() {
 	proto.RegisterType((*AnalystsInfo)(nil), "loggrebutterfly.AnalystsInfo")
 	proto.RegisterType((*AnalystsResponse)(nil), "loggrebutterfly.AnalystsResponse")
 	proto.RegisterType((*AnalystInfo)(nil), "loggrebutterfly.AnalystInfo")
+	proto.RegisterType((*DecisionsInfo)(nil), "loggrebutterfly.DecisionsInfo")
+	proto.RegisterType((*DecisionsResponse)(nil), "loggrebutterfly.DecisionsResponse")
+	proto.RegisterType((*Decision)(nil), "loggrebutterfly.Decision")
+	proto.RegisterType((*RangeMetric)(nil), "loggrebutterfly.RangeMetric")
+	proto.RegisterType((*DecisionThresholds)(nil), "loggrebutterfly.DecisionThresholds")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -128,6 +304,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MasterClient interface {
 	Routes(ctx context.Context, in *RoutesInfo, opts ...grpc.CallOption) (*RoutesResponse, error)
 	Analysts(ctx context.Context, in *AnalystsInfo, opts ...grpc.CallOption) (*AnalystsResponse, error)
+	Decisions(ctx context.Context, in *DecisionsInfo, opts ...grpc.CallOption) (*DecisionsResponse, error)
 }
 
 type masterClient struct {
@@ -156,11 +333,21 @@ func (c *masterClient) Analysts(ctx context.Context, in *AnalystsInfo, opts ...g
 	return out, nil
 }
 
+func (c *masterClient) Decisions(ctx context.Context, in *DecisionsInfo, opts ...grpc.CallOption) (*DecisionsResponse, error) {
+	out := new(DecisionsResponse)
+	err := grpc.Invoke(ctx, "/loggrebutterfly.Master/Decisions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Master service
 
 type MasterServer interface {
 	Routes(context.Context, *RoutesInfo) (*RoutesResponse, error)
 	Analysts(context.Context, *AnalystsInfo) (*AnalystsResponse, error)
+	Decisions(context.Context, *DecisionsInfo) (*DecisionsResponse, error)
 }
 
 func RegisterMasterServer(s *grpc.Server, srv MasterServer) {
@@ -203,6 +390,24 @@ func _Master_Analysts_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Master_Decisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecisionsInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).Decisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loggrebutterfly.Master/Decisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).Decisions(ctx, req.(*DecisionsInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Master_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "loggrebutterfly.Master",
 	HandlerType: (*MasterServer)(nil),
@@ -215,29 +420,53 @@ var _Master_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Analysts",
 			Handler:    _Master_Analysts_Handler,
 		},
+		{
+			MethodName: "Decisions",
+			Handler:    _Master_Decisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "master.proto",
 }
 
-func init() { proto.RegisterFile("master.proto", fileDescriptor2) }
-
-var fileDescriptor2 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x09, 0x20, 0xab, 0xbd, 0x46, 0x05, 0xdd, 0x80, 0xa2, 0x02, 0xa2, 0xf5, 0xd4, 0x29,
-	0x43, 0x18, 0x60, 0x45, 0x62, 0x00, 0x09, 0x18, 0xf2, 0x06, 0xae, 0x72, 0xed, 0x92, 0xda, 0x95,
-	0xed, 0x0e, 0x79, 0x1d, 0x9e, 0x14, 0xe5, 0x6c, 0x07, 0x04, 0x64, 0xf3, 0xfd, 0x77, 0xff, 0xaf,
-	0xef, 0xce, 0x90, 0xef, 0x95, 0xf3, 0x64, 0xcb, 0x83, 0x35, 0xde, 0xe0, 0x45, 0x6b, 0x76, 0x3b,
-	0x4b, 0x9b, 0xa3, 0xf7, 0x64, 0xb7, 0x6d, 0x27, 0x73, 0x80, 0xda, 0x1c, 0x3d, 0xb9, 0x57, 0xbd,
-	0x35, 0xf2, 0x19, 0xe6, 0xa1, 0xaa, 0xc9, 0x1d, 0x8c, 0x76, 0x84, 0x15, 0x08, 0xcb, 0x4a, 0x91,
-	0x2d, 0xcf, 0xd6, 0xb3, 0x6a, 0x51, 0xfe, 0x4a, 0x28, 0xd9, 0xd0, 0xbb, 0xeb, 0x38, 0x29, 0x1f,
-	0x60, 0x3a, 0x88, 0x88, 0x70, 0xae, 0xd5, 0x9e, 0x8a, 0x6c, 0x99, 0xad, 0xa7, 0x35, 0xbf, 0xf1,
-	0x0a, 0x44, 0x4b, 0xaa, 0x21, 0x5b, 0x9c, 0xb2, 0x1a, 0x2b, 0x39, 0x87, 0xfc, 0x49, 0xab, 0xb6,
-	0x73, 0x3e, 0xe0, 0xbc, 0xc1, 0x65, 0xaa, 0x07, 0xa0, 0x47, 0x98, 0xa8, 0xa8, 0x45, 0xa4, 0x9b,
-	0x3f, 0x48, 0xd1, 0xc4, 0x50, 0xc3, 0xb4, 0x5c, 0xc1, 0xec, 0x47, 0xa3, 0x07, 0x53, 0x4d, 0x63,
-	0x13, 0x58, 0xff, 0xae, 0x3e, 0x33, 0x10, 0xef, 0x7c, 0x2f, 0x7c, 0x01, 0x11, 0x4e, 0x81, 0xd7,
-	0xff, 0xaf, 0xcc, 0x88, 0x8b, 0xbb, 0x91, 0x66, 0xe2, 0x95, 0x27, 0xf8, 0x01, 0x93, 0xb4, 0x05,
-	0xde, 0x8e, 0xb1, 0x86, 0xb4, 0xd5, 0x68, 0xfb, 0x3b, 0x6f, 0x23, 0xf8, 0x2b, 0xef, 0xbf, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x1b, 0xcb, 0xbf, 0x48, 0xda, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("master.proto", fileDescriptor3) }
+
+var fileDescriptor3 = []byte{
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x26, 0x4d, 0x97, 0x25, 0x2f, 0x65, 0x0c, 0x0b, 0x41, 0xe8, 0xc6, 0xda, 0x19, 0x09, 0xf5,
+	0xd4, 0x43, 0x39, 0x6c, 0xe2, 0x04, 0xda, 0x90, 0x40, 0x6c, 0x93, 0xb0, 0x38, 0x20, 0x71, 0xa8,
+	0xbc, 0xc6, 0xed, 0x2c, 0x12, 0xa7, 0xb2, 0x9d, 0xb1, 0xfc, 0x67, 0xae, 0xdc, 0x51, 0xec, 0x24,
+	0xcd, 0xd6, 0xe5, 0xe6, 0xf7, 0x7d, 0xef, 0x7d, 0xfd, 0xbe, 0xda, 0x2f, 0x30, 0x48, 0xa9, 0xd2,
+	0x4c, 0x4e, 0xd7, 0x32, 0xd3, 0x19, 0x7a, 0x96, 0x64, 0xab, 0x95, 0x64, 0xd7, 0xb9, 0xd6, 0x4c,
+	0x2e, 0x93, 0x02, 0x0f, 0x00, 0x48, 0x96, 0x6b, 0xa6, 0xbe, 0x8a, 0x65, 0x86, 0xcf, 0x61, 0xcf,
+	0x56, 0x84, 0xa9, 0x75, 0x26, 0x14, 0x43, 0x33, 0xf0, 0xa4, 0x41, 0x22, 0x67, 0xec, 0x4e, 0xc2,
+	0xd9, 0x70, 0xfa, 0x40, 0x61, 0x6a, 0x06, 0xca, 0x69, 0x52, 0x75, 0xe2, 0x13, 0x08, 0x1a, 0x10,
+	0x21, 0xe8, 0x0b, 0x9a, 0xb2, 0xc8, 0x19, 0x3b, 0x93, 0x80, 0x98, 0x33, 0x7a, 0x09, 0x5e, 0xc2,
+	0x68, 0xcc, 0x64, 0xd4, 0x33, 0x68, 0x55, 0xe1, 0x3d, 0x18, 0x7c, 0x12, 0x34, 0x29, 0x94, 0xb6,
+	0x76, 0x2e, 0x60, 0xbf, 0xae, 0x1b, 0x43, 0xa7, 0xe0, 0xd3, 0x0a, 0xab, 0x2c, 0x1d, 0x6e, 0x59,
+	0xaa, 0x86, 0x8c, 0xa9, 0xa6, 0x1b, 0x1f, 0x43, 0xd8, 0x22, 0x4a, 0x63, 0x34, 0x8e, 0x65, 0x6d,
+	0xac, 0x3c, 0xe3, 0x5f, 0xf0, 0xf4, 0x9c, 0x2d, 0xb8, 0xe2, 0x99, 0x30, 0x0e, 0xd0, 0x11, 0x40,
+	0x4a, 0xb9, 0xd0, 0x94, 0x0b, 0x56, 0xb7, 0xb6, 0x10, 0xf4, 0x02, 0x76, 0x94, 0xa6, 0x52, 0x9b,
+	0x20, 0x2e, 0xb1, 0x45, 0x89, 0x26, 0x3c, 0xe5, 0x3a, 0x72, 0xc7, 0xce, 0xa4, 0x4f, 0x6c, 0x81,
+	0x2f, 0xe0, 0x79, 0x23, 0xde, 0xc4, 0x39, 0x81, 0x20, 0xae, 0xc1, 0x2a, 0xcf, 0xeb, 0xad, 0x3c,
+	0xf5, 0x18, 0xd9, 0xf4, 0xe2, 0xbf, 0x3d, 0xf0, 0x6b, 0x1c, 0x1d, 0x42, 0xa0, 0x79, 0xca, 0x94,
+	0xa6, 0xe9, 0xda, 0xb8, 0x74, 0xc9, 0x06, 0x78, 0x10, 0xa2, 0xb7, 0x15, 0x02, 0x41, 0x7f, 0xc9,
+	0x13, 0x66, 0xdc, 0x06, 0xc4, 0x9c, 0xd1, 0x2b, 0xd8, 0x8d, 0x65, 0x31, 0x97, 0xb9, 0x88, 0xfa,
+	0x63, 0x67, 0xe2, 0x13, 0x2f, 0x96, 0x05, 0xc9, 0x45, 0x99, 0x8d, 0x49, 0x99, 0xc9, 0x68, 0xc7,
+	0x74, 0xdb, 0x02, 0x7d, 0x84, 0xdd, 0x94, 0x69, 0xc9, 0x17, 0x2a, 0xf2, 0x4c, 0x88, 0x77, 0x9d,
+	0x21, 0xa6, 0x97, 0xb6, 0xf1, 0xb3, 0xd0, 0xb2, 0x20, 0xf5, 0x18, 0x3a, 0x03, 0xd0, 0x37, 0x92,
+	0xa9, 0x9b, 0x2c, 0x89, 0x55, 0xb4, 0x3b, 0x76, 0x26, 0xe1, 0xec, 0x6d, 0xa7, 0xc8, 0x8f, 0xa6,
+	0x95, 0xb4, 0xc6, 0x86, 0x3f, 0x61, 0xd0, 0x56, 0x47, 0xfb, 0xe0, 0xfe, 0x66, 0x45, 0x75, 0x6f,
+	0xe5, 0x11, 0xcd, 0x60, 0xe7, 0x96, 0x26, 0x39, 0x33, 0x7f, 0xc3, 0x63, 0x6f, 0x87, 0x50, 0xb1,
+	0x62, 0x56, 0x84, 0xd8, 0xd6, 0x0f, 0xbd, 0x53, 0x07, 0x7f, 0x83, 0xb0, 0xc5, 0xa0, 0x11, 0x84,
+	0x7f, 0x24, 0xd7, 0x6c, 0xbe, 0xc8, 0x72, 0xa1, 0xcd, 0x0f, 0xf4, 0x09, 0x18, 0xe8, 0xac, 0x44,
+	0xd0, 0x01, 0x04, 0x4c, 0xca, 0x8a, 0xee, 0x19, 0xda, 0x67, 0x52, 0x1a, 0x12, 0x67, 0x80, 0xb6,
+	0x83, 0x94, 0x23, 0x29, 0x17, 0xf7, 0x14, 0xfd, 0x94, 0x8b, 0x46, 0x2f, 0xa5, 0x77, 0xf7, 0xf5,
+	0x52, 0x7a, 0x67, 0xc9, 0x11, 0x84, 0x5c, 0x68, 0x26, 0x6f, 0x69, 0x32, 0x17, 0xca, 0xdc, 0xa3,
+	0x4b, 0xa0, 0x86, 0xae, 0xd4, 0xec, 0x9f, 0x03, 0xde, 0xa5, 0xf9, 0x0e, 0xa0, 0x2f, 0xe0, 0xd9,
+	0x15, 0x47, 0x07, 0x8f, 0xaf, 0xb2, 0x79, 0xf8, 0xc3, 0x51, 0x07, 0x59, 0x3f, 0x5c, 0xfc, 0x04,
+	0x5d, 0x81, 0x5f, 0x6f, 0x27, 0x7a, 0xd3, 0xb5, 0x83, 0x56, 0xed, 0xb8, 0x93, 0x6e, 0xe9, 0x7d,
+	0x87, 0xa0, 0xd9, 0x0f, 0x74, 0xd4, 0x79, 0xf5, 0x56, 0x11, 0x77, 0xf3, 0x1b, 0xc9, 0x6b, 0xcf,
+	0x7c, 0xf5, 0xde, 0xff, 0x1f, 0x00, 0xfa, 0x30, 0x59, 0xac, 0x05, 0x05, 0x00, 0x00,
 }
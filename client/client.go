@@ -2,10 +2,15 @@ package client
 
 import (
 	"io"
+	"sort"
+	"time"
 
 	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	pb "github.com/poy/loggrebutterfly/api/v1"
 	"github.com/poy/loggrebutterfly/client/internal/filesystem"
-	"github.com/poy/loggrebutterfly/client/internal/hasher"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+	"github.com/poy/loggrebutterfly/internal/hlc"
+	"github.com/poy/loggrebutterfly/internal/sequence"
 	"github.com/poy/petasos/reader"
 	"github.com/poy/petasos/router"
 	"github.com/golang/protobuf/proto"
@@ -13,35 +18,111 @@ import (
 )
 
 type Client struct {
-	router *router.Router
-	reader *reader.RouteReader
-	hasher *hasher.Hasher
+	router  *router.Router
+	fs      *filesystem.FileSystem
+	hasher  *hashing.Hasher
+	stamper *sequence.Stamper
+	quota   *quota
 }
 
-func New(masterAddr string) *Client {
+type ClientOption func(*options)
+
+type options struct {
+	hashVersion uint64
+	hotSources  map[string]string
+	epoch       int64
+}
+
+// WithProducerEpoch sets the epoch that is stamped on every envelope.
+// Defaults to the time the client was created.
+func WithProducerEpoch(epoch int64) ClientOption {
+	return func(o *options) {
+		o.epoch = epoch
+	}
+}
+
+// WithHashVersion sets the hash scheme used to route envelopes. It must
+// match the data nodes' HASH_VERSION. Defaults to the current version.
+func WithHashVersion(version uint64) ClientOption {
+	return func(o *options) {
+		o.hashVersion = version
+	}
+}
+
+// WithHotSources maps source IDs to a tag that is used as a secondary
+// routing key. It must match the data nodes' HOT_SOURCE_LIST.
+func WithHotSources(hotSources map[string]string) ClientOption {
+	return func(o *options) {
+		o.hotSources = hotSources
+	}
+}
+
+// New returns a Client for the cluster behind the master. It returns an
+// error for an unknown hash version.
+func New(masterAddr string, opts ...ClientOption) (*Client, error) {
+	o := options{
+		hashVersion: hashing.CurrentVersion,
+		epoch:       time.Now().UnixNano(),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	hasher, err := hashing.New(o.hashVersion, o.hotSources)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := newQuota(time.Now)
 	cache := filesystem.NewCache(masterAddr)
-	fs := filesystem.New(cache)
-	hasher := hasher.New()
+	fs := filesystem.New(cache, o.hashVersion, quota)
 
 	counter := router.NewCounter()
 	router := router.New(fs, hasher, counter)
 
-	reader := reader.NewRouteReader(fs)
-
 	return &Client{
-		hasher: hasher,
-		router: router,
-		reader: reader,
-	}
+		hasher:  hasher,
+		router:  router,
+		fs:      fs,
+		stamper: sequence.NewStamper(o.epoch, o.hotSources),
+		quota:   quota,
+	}, nil
 }
 
+// writeAttempts is how many times Write tries an envelope that was not
+// acknowledged by the data node.
+const writeAttempts = 3
+
+// Write stamps the envelope with the producer epoch and the next sequence
+// number for its source and writes it. It returns once the data node has
+// handed the envelope to talaria. Envelopes that are not acknowledged are
+// only written again while the data nodes report that they drop the
+// duplicates by their sequence number. It returns an *OverQuotaError while
+// the data node is throttling the source and a *RejectedError if the data
+// node rejected the envelope.
 func (c *Client) Write(e *v2.Envelope) error {
-	data, err := proto.Marshal(e)
+	if err := c.quota.check(e.SourceId); err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(c.stamper.Stamp(e))
 	if err != nil {
 		return err
 	}
 
-	return c.router.Write(data)
+	for i := 1; ; i++ {
+		err = c.router.Write(data)
+		if r, ok := err.(*filesystem.Rejection); ok {
+			err = rejected(r)
+			if r.Code != pb.RejectionCode_WRITE_FAILED {
+				return err
+			}
+		}
+
+		if err == nil || i == writeAttempts || !c.fs.Deduplicated() {
+			return err
+		}
+	}
 }
 
 type DataPacket struct {
@@ -50,15 +131,89 @@ type DataPacket struct {
 	Index    uint64
 }
 
-func (c *Client) ReadFrom(sourceID string) (func() (DataPacket, error), error) {
-	hash := c.hasher.HashString(sourceID)
+type ReadOption func(*readOptions)
+
+type readOptions struct {
+	hlcOrder bool
+}
+
+// WithHLCOrder reads every envelope of the source before returning any and
+// returns them ordered by the hybrid logical clock value stamped by the
+// data nodes. Two readers always see the same order for the same data.
+func WithHLCOrder() ReadOption {
+	return func(o *readOptions) {
+		o.hlcOrder = true
+	}
+}
+
+func (c *Client) ReadFrom(sourceID string, opts ...ReadOption) (func() (DataPacket, error), error) {
+	var o readOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	read, err := c.readFrom(sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.hlcOrder {
+		return read, nil
+	}
+
+	var packets []DataPacket
+	for {
+		p, err := read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, p)
+	}
+
+	sort.SliceStable(packets, func(i, j int) bool {
+		return hlc.Of(packets[i].Envelope).Less(hlc.Of(packets[j].Envelope))
+	})
+
+	return func() (DataPacket, error) {
+		if len(packets) == 0 {
+			return DataPacket{}, io.EOF
+		}
+
+		p := packets[0]
+		packets = packets[1:]
+		return p, nil
+	}, nil
+}
+
+// readFrom reads every range that can hold the source's envelopes. A hot
+// source is spread across several ranges, they are read one after the
+// other.
+func (c *Client) readFrom(sourceID string) (func() (DataPacket, error), error) {
+	hashes, err := c.routeHashes(sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The data nodes only send the source's envelopes. The source check
+	// below still guards against data nodes that ignore the filter.
+	fs := c.fs.WithFilter(&pb.AnalystFilter{SourceId: sourceID})
+	rr := reader.NewRouteReader(fs)
+
+	var readers []reader.Reader
+	for _, h := range hashes {
+		readers = append(readers, rr.ReadFrom(h))
+	}
 
-	r := c.reader.ReadFrom(hash)
 	return func() (DataPacket, error) {
-		for {
-			data, err := r.Read()
+		for len(readers) > 0 {
+			data, err := readers[0].Read()
 			if grpc.ErrorDesc(err) == "EOF" {
-				return DataPacket{}, io.EOF
+				readers = readers[1:]
+				continue
 			}
 
 			if err != nil {
@@ -80,5 +235,101 @@ func (c *Client) ReadFrom(sourceID string) (func() (DataPacket, error), error) {
 				Index:    data.Index,
 			}, nil
 		}
+
+		return DataPacket{}, io.EOF
+	}, nil
+}
+
+// routeHashes returns a hash inside each range that can hold the source's
+// envelopes.
+func (c *Client) routeHashes(sourceID string) ([]uint64, error) {
+	low, high := c.hasher.HashRange(sourceID)
+	if low == high {
+		return []uint64{low}, nil
+	}
+
+	files, err := c.fs.List()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]bool)
+	var hashes []uint64
+	for _, file := range files {
+		rn, err := hashing.ParseRangeName(file)
+		if err != nil || rn.Low > high || rn.High < low {
+			continue
+		}
+
+		h := low
+		if rn.Low > h {
+			h = rn.Low
+		}
+
+		// Each term of a range is listed; it is read once.
+		if !seen[h] {
+			seen[h] = true
+			hashes = append(hashes, h)
+		}
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+
+	return hashes, nil
+}
+
+// SequenceRange is an inclusive range of sequence numbers from one producer
+// epoch. Stream is only set for hot sources, each of their secondary
+// routing keys has its own sequence.
+type SequenceRange struct {
+	Stream string
+	Epoch  int64
+	Low    uint64
+	High   uint64
+}
+
+type SequenceReport struct {
+	Missing    []SequenceRange
+	Duplicated []SequenceRange
+}
+
+// Verify reads a source back and reports the sequence numbers that are
+// missing or were read more than once.
+func (c *Client) Verify(sourceID string) (SequenceReport, error) {
+	read, err := c.ReadFrom(sourceID)
+	if err != nil {
+		return SequenceReport{}, err
+	}
+
+	v := sequence.NewVerifier()
+	for {
+		data, err := read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return SequenceReport{}, err
+		}
+
+		v.Observe(data.Envelope)
+	}
+
+	return SequenceReport{
+		Missing:    convertRanges(v.Missing()),
+		Duplicated: convertRanges(v.Duplicated()),
 	}, nil
 }
+
+func convertRanges(ranges []sequence.Range) []SequenceRange {
+	var result []SequenceRange
+	for _, r := range ranges {
+		result = append(result, SequenceRange{
+			Stream: r.Stream,
+			Epoch:  r.Epoch,
+			Low:    r.Low,
+			High:   r.High,
+		})
+	}
+
+	return result
+}
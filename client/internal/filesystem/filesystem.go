@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
 
 	pb "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 	"github.com/poy/petasos/reader"
 	"github.com/poy/petasos/router"
 )
@@ -19,14 +23,47 @@ type RouteCache interface {
 	Reset()
 }
 
+type QuotaObserver interface {
+	OverQuota(sourceID string, retryAfter time.Duration)
+}
+
 type FileSystem struct {
-	cache RouteCache
+	cache        RouteCache
+	hashVersion  uint64
+	quota        QuotaObserver
+	filter       *pb.AnalystFilter
+	deduplicated *int32
 }
 
-func New(cache RouteCache) *FileSystem {
+func New(cache RouteCache, hashVersion uint64, quota QuotaObserver) *FileSystem {
 	return &FileSystem{
-		cache: cache,
+		cache:        cache,
+		hashVersion:  hashVersion,
+		quota:        quota,
+		deduplicated: new(int32),
+	}
+}
+
+// Deduplicated reports whether the last response from a data node said
+// that it drops duplicate envelopes.
+func (f *FileSystem) Deduplicated() bool {
+	return atomic.LoadInt32(f.deduplicated) == 1
+}
+
+func (f *FileSystem) setDeduplicated(deduplicated bool) {
+	var v int32
+	if deduplicated {
+		v = 1
 	}
+	atomic.StoreInt32(f.deduplicated, v)
+}
+
+// WithFilter returns a FileSystem whose readers ask the data nodes to only
+// send the envelopes that match the filter.
+func (f *FileSystem) WithFilter(filter *pb.AnalystFilter) *FileSystem {
+	c := *f
+	c.filter = filter
+	return &c
 }
 
 func (f *FileSystem) List() (files []string, err error) {
@@ -34,6 +71,11 @@ func (f *FileSystem) List() (files []string, err error) {
 	if len(list) == 0 {
 		return nil, fmt.Errorf("unable to fetch route list")
 	}
+
+	list = hashing.FilterVersion(list, f.hashVersion)
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no routes for hash version %d", f.hashVersion)
+	}
 	return list, nil
 }
 
@@ -43,18 +85,24 @@ func (f *FileSystem) Writer(name string) (writer router.Writer, err error) {
 		return nil, fmt.Errorf("unknown file: %s", name)
 	}
 
-	ctx, _ := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	sender, err := client.Write(ctx)
 	if err != nil {
+		cancel()
 		f.cache.Reset()
 		return nil, err
 	}
 
 	wrapper := &senderWrapper{
-		sender: sender,
-		addr:   addr,
-		reset:  f.cache.Reset,
+		sender:  sender,
+		addr:    addr,
+		cancel:  cancel,
+		reset:   f.cache.Reset,
+		quota:   f.quota,
+		dedup:   f.setDeduplicated,
+		pending: make(map[uint64]chan error),
 	}
+	go wrapper.readAcks()
 
 	return wrapper, nil
 }
@@ -66,39 +114,193 @@ func (f *FileSystem) Reader(name string, startingIndex uint64) (reader reader.Re
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	rx, err := client.Read(ctx, &pb.ReadInfo{Name: name, Index: startingIndex})
+	rx, err := client.Read(ctx, &pb.ReadInfo{
+		Name:   name,
+		Index:  startingIndex,
+		Filter: f.filter,
+	})
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
 	return &receiverWrapper{rx: rx, cancel: cancel, addr: addr, resetCache: f.cache.Reset}, nil
 }
 
+// Rejection is returned by a writer when the data node rejected the data.
+type Rejection struct {
+	Code       pb.RejectionCode
+	Reason     string
+	SourceID   string
+	RetryAfter time.Duration
+}
+
+func (r *Rejection) Error() string {
+	return fmt.Sprintf("rejected (%s): %s", r.Code, r.Reason)
+}
+
 type senderWrapper struct {
 	addr   string
 	sender pb.DataNode_WriteClient
-	err    error
+	cancel func()
 	reset  func()
+	quota  QuotaObserver
+	dedup  func(deduplicated bool)
+	sendMu sync.Mutex
+
+	mu       sync.Mutex
+	err      error
+	sequence uint64
+	inFlight bool
+	queue    []queuedWrite
+	pending  map[uint64]chan error
+}
+
+type queuedWrite struct {
+	data []byte
+	done chan error
 }
 
+// Write queues the data and waits for the data node to acknowledge it.
+// Writes that queue up while a batch waits for its ack are sent together
+// as the next batch. A rejected write returns a *Rejection. Any other
+// error means the data was never acknowledged and may be written again.
 func (w *senderWrapper) Write(data []byte) error {
+	w.mu.Lock()
 	if w.err != nil {
-		return w.err
+		w.mu.Unlock()
+		return fmt.Errorf("[WRITE TO %s]: %s", w.addr, w.err)
 	}
 
-	err := w.sender.Send(&pb.WriteInfo{Payload: data})
-	if err != nil {
-		w.err = err
-		w.reset()
+	done := make(chan error, 1)
+	w.queue = append(w.queue, queuedWrite{data: data, done: done})
+	info := w.next()
+	w.mu.Unlock()
 
-		return fmt.Errorf("[WRITE TO %s]: %s", w.addr, w.err)
+	w.send(info)
+	return <-done
+}
+
+// next takes the queued writes as the next batch unless a batch is waiting
+// for its ack. It returns nil if there is nothing to send. It must be
+// called with mu held.
+func (w *senderWrapper) next() *pb.WriteInfo {
+	if w.inFlight || len(w.queue) == 0 {
+		return nil
+	}
+
+	info := &pb.WriteInfo{Sequence: w.sequence + 1}
+	for _, q := range w.queue {
+		w.sequence++
+		w.pending[w.sequence] = q.done
+		info.Payloads = append(info.Payloads, q.data)
+	}
+	w.queue = nil
+	w.inFlight = true
+
+	return info
+}
+
+func (w *senderWrapper) send(info *pb.WriteInfo) {
+	if info == nil {
+		return
 	}
 
-	return nil
+	w.sendMu.Lock()
+	err := w.sender.Send(info)
+	w.sendMu.Unlock()
+
+	if err != nil {
+		w.fail(err)
+	}
 }
 
 func (w *senderWrapper) Close() {
-	w.sender.CloseAndRecv()
+	w.sendMu.Lock()
+	defer w.sendMu.Unlock()
+
+	w.sender.CloseSend()
+}
+
+// readAcks reads the acks from the data node and completes the pending
+// writes. Sources that are over their quota are reported.
+func (w *senderWrapper) readAcks() {
+	for {
+		resp, err := w.sender.Recv()
+		if err != nil {
+			w.fail(err)
+			return
+		}
+
+		w.ack(resp)
+	}
+}
+
+func (w *senderWrapper) ack(resp *pb.WriteResponse) {
+	w.dedup(resp.Deduplicated)
+
+	rejections := make(map[uint64]*Rejection)
+	for _, r := range resp.Rejections {
+		log.Printf("[WRITE TO %s]: envelope %d was rejected: %s", w.addr, r.Sequence, r.Reason)
+		if r.Code == pb.RejectionCode_OVER_QUOTA {
+			w.quota.OverQuota(r.SourceId, time.Duration(r.RetryAfterNs))
+		}
+
+		rejections[r.Sequence] = &Rejection{
+			Code:       r.Code,
+			Reason:     r.Reason,
+			SourceID:   r.SourceId,
+			RetryAfter: time.Duration(r.RetryAfterNs),
+		}
+	}
+
+	w.mu.Lock()
+	for seq, done := range w.pending {
+		if seq > resp.Ack {
+			continue
+		}
+
+		var err error
+		if r, ok := rejections[seq]; ok {
+			err = r
+		}
+		done <- err
+		delete(w.pending, seq)
+	}
+
+	if len(w.pending) == 0 {
+		w.inFlight = false
+	}
+	info := w.next()
+	w.mu.Unlock()
+
+	w.send(info)
+}
+
+// fail completes every pending write with the error. The writer can not be
+// used afterwards. io.EOF is the data node ending the stream after Close.
+func (w *senderWrapper) fail(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err == nil {
+		w.err = err
+		w.cancel()
+		if err != io.EOF {
+			w.reset()
+		}
+	}
+
+	err = fmt.Errorf("[WRITE TO %s]: %s", w.addr, err)
+	for seq, done := range w.pending {
+		done <- err
+		delete(w.pending, seq)
+	}
+
+	for _, q := range w.queue {
+		q.done <- err
+	}
+	w.queue = nil
 }
 
 type receiverWrapper struct {
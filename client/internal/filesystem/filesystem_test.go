@@ -9,6 +9,7 @@ import (
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
@@ -16,6 +17,7 @@ import (
 	"github.com/poy/eachers/testhelpers"
 	pb "github.com/poy/loggrebutterfly/api/v1"
 	"github.com/poy/loggrebutterfly/client/internal/filesystem"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -37,6 +39,7 @@ type TFS struct {
 	mockDataNodeServers []*mockDataNodeServer
 	dataNodeClients     []pb.DataNodeClient
 	mockRouteCache      *mockRouteCache
+	mockQuotaObserver   *mockQuotaObserver
 	fs                  *filesystem.FileSystem
 }
 
@@ -68,6 +71,23 @@ func TestFileSystemList(t *testing.T) {
 			))
 		})
 	})
+
+	o.Group("cache returns routes from other hash versions", func() {
+		o.BeforeEach(func(t TFS) TFS {
+			t.mockRouteCache.ListOutput.Ret0 <- []string{
+				`{"low":0,"high":9}`,
+				`{"low":0,"high":9,"hash_version":2}`,
+			}
+			return t
+		})
+
+		o.Spec("it only lists the routes for its hash version", func(t TFS) {
+			list, err := t.fs.List()
+			Expect(t, err == nil).To(BeTrue())
+			Expect(t, list).To(HaveLen(1))
+			Expect(t, list).To(Contain(`{"low":0,"high":9}`))
+		})
+	})
 }
 
 func TestFileSystemWriter(t *testing.T) {
@@ -92,8 +112,25 @@ func TestFileSystemWriter(t *testing.T) {
 			o.Spec("it writes to the correct data node", func(t TFS) {
 				writer, err := t.fs.Writer("some-name-b")
 				Expect(t, err == nil).To(BeTrue())
-				err = writer.Write([]byte("some-data"))
+				go writer.Write([]byte("some-data"))
+
+				var rx pb.DataNode_WriteServer
+				Expect(t, t.mockDataNodeServers[1].WriteInput.Arg0).To(ViaPolling(
+					Chain(Receive(), Fetch(&rx)),
+				))
+				data, err := rx.Recv()
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, data.Payloads).To(Equal([][]byte{[]byte("some-data")}))
+				Expect(t, data.Sequence).To(Equal(uint64(1)))
+			})
+
+			o.Spec("it batches the writes that wait for an ack", func(t TFS) {
+				writer, err := t.fs.Writer("some-name-b")
 				Expect(t, err == nil).To(BeTrue())
+				errs := make(chan error, 3)
+				go func() {
+					errs <- writer.Write([]byte("a"))
+				}()
 
 				var rx pb.DataNode_WriteServer
 				Expect(t, t.mockDataNodeServers[1].WriteInput.Arg0).To(ViaPolling(
@@ -101,7 +138,117 @@ func TestFileSystemWriter(t *testing.T) {
 				))
 				data, err := rx.Recv()
 				Expect(t, err == nil).To(BeTrue())
-				Expect(t, data.Payload).To(Equal([]byte("some-data")))
+				Expect(t, data.Payloads).To(HaveLen(1))
+
+				for _, d := range []string{"b", "c"} {
+					go func(d string) {
+						errs <- writer.Write([]byte(d))
+					}(d)
+				}
+				Expect(t, errs).To(Always(HaveLen(0)))
+				Expect(t, rx.Send(&pb.WriteResponse{Ack: 1}) == nil).To(BeTrue())
+
+				data, err = rx.Recv()
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, data.Sequence).To(Equal(uint64(2)))
+				Expect(t, data.Payloads).To(HaveLen(2))
+				Expect(t, rx.Send(&pb.WriteResponse{Ack: 3}) == nil).To(BeTrue())
+
+				for i := 0; i < 3; i++ {
+					Expect(t, errs).To(ViaPolling(
+						Chain(Receive(), Equal(nil)),
+					))
+				}
+			})
+
+			o.Spec("it waits for the data node to acknowledge the write", func(t TFS) {
+				writer, err := t.fs.Writer("some-name-b")
+				Expect(t, err == nil).To(BeTrue())
+				errs := make(chan error, 1)
+				go func() {
+					errs <- writer.Write([]byte("some-data"))
+				}()
+
+				var rx pb.DataNode_WriteServer
+				Expect(t, t.mockDataNodeServers[1].WriteInput.Arg0).To(ViaPolling(
+					Chain(Receive(), Fetch(&rx)),
+				))
+				_, err = rx.Recv()
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, errs).To(Always(HaveLen(0)))
+
+				err = rx.Send(&pb.WriteResponse{Ack: 1})
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, errs).To(ViaPolling(
+					Chain(Receive(), Equal(nil)),
+				))
+				Expect(t, t.fs.Deduplicated()).To(BeFalse())
+			})
+
+			o.Spec("it records that the data node drops duplicates", func(t TFS) {
+				writer, err := t.fs.Writer("some-name-b")
+				Expect(t, err == nil).To(BeTrue())
+				errs := make(chan error, 1)
+				go func() {
+					errs <- writer.Write([]byte("some-data"))
+				}()
+
+				var rx pb.DataNode_WriteServer
+				Expect(t, t.mockDataNodeServers[1].WriteInput.Arg0).To(ViaPolling(
+					Chain(Receive(), Fetch(&rx)),
+				))
+				_, err = rx.Recv()
+				Expect(t, err == nil).To(BeTrue())
+
+				err = rx.Send(&pb.WriteResponse{Ack: 1, Deduplicated: true})
+				Expect(t, err == nil).To(BeTrue())
+				Expect(t, errs).To(ViaPolling(
+					Chain(Receive(), Equal(nil)),
+				))
+				Expect(t, t.fs.Deduplicated()).To(BeTrue())
+			})
+
+			o.Spec("it returns the rejection and reports sources that are over quota", func(t TFS) {
+				writer, err := t.fs.Writer("some-name-b")
+				Expect(t, err == nil).To(BeTrue())
+				errs := make(chan error, 1)
+				go func() {
+					errs <- writer.Write([]byte("some-data"))
+				}()
+
+				var rx pb.DataNode_WriteServer
+				Expect(t, t.mockDataNodeServers[1].WriteInput.Arg0).To(ViaPolling(
+					Chain(Receive(), Fetch(&rx)),
+				))
+				_, err = rx.Recv()
+				Expect(t, err == nil).To(BeTrue())
+
+				err = rx.Send(&pb.WriteResponse{
+					Ack: 1,
+					Rejections: []*pb.Rejection{
+						{
+							Sequence:     1,
+							Code:         pb.RejectionCode_OVER_QUOTA,
+							SourceId:     "some-id",
+							RetryAfterNs: int64(time.Second),
+						},
+					},
+				})
+				Expect(t, err == nil).To(BeTrue())
+
+				Expect(t, errs).To(ViaPolling(
+					Chain(Receive(), Equal(&filesystem.Rejection{
+						Code:       pb.RejectionCode_OVER_QUOTA,
+						SourceID:   "some-id",
+						RetryAfter: time.Second,
+					})),
+				))
+				Expect(t, t.mockQuotaObserver.OverQuotaInput.SourceID).To(ViaPolling(
+					Chain(Receive(), Equal("some-id")),
+				))
+				Expect(t, t.mockQuotaObserver.OverQuotaInput.RetryAfter).To(ViaPolling(
+					Chain(Receive(), Equal(time.Second)),
+				))
 			})
 		})
 
@@ -120,6 +267,16 @@ func TestFileSystemWriter(t *testing.T) {
 				}
 				Expect(t, f).To(ViaPolling(BeTrue()))
 			})
+
+			o.Spec("it does not return a rejection for a write that was never acknowledged", func(t TFS) {
+				writer, err := t.fs.Writer("some-name-b")
+				Expect(t, err == nil).To(BeTrue())
+
+				err = writer.Write([]byte("some-data"))
+				Expect(t, err == nil).To(BeFalse())
+				_, ok := err.(*filesystem.Rejection)
+				Expect(t, ok).To(BeFalse())
+			})
 		})
 	})
 
@@ -208,6 +365,22 @@ func TestFileSystemReader(t *testing.T) {
 			Expect(t, data.Filename).To(Equal("file-a"))
 			Expect(t, data.Index).To(Equal(uint64(101)))
 		})
+
+		o.Spec("it sends the filter to the data node", func(t TFS) {
+			close(t.mockDataNodeServers[1].ReadOutput.Ret0)
+			filter := &pb.AnalystFilter{SourceId: "some-id"}
+
+			_, err := t.fs.WithFilter(filter).Reader("some-name-b", 99)
+			Expect(t, err == nil).To(BeTrue())
+
+			Expect(t, t.mockDataNodeServers[1].ReadInput.Arg0).To(ViaPolling(
+				Chain(Receive(), Equal(&pb.ReadInfo{
+					Name:   "some-name-b",
+					Index:  99,
+					Filter: filter,
+				})),
+			))
+		})
 	})
 }
 
@@ -216,14 +389,16 @@ func setup(o *onpar.Onpar) {
 		dataNodeAddrA, mockDataNodeServerA := startMockDataNode()
 		dataNodeAddrB, mockDataNodeServerB := startMockDataNode()
 		mockRouteCache := newMockRouteCache()
+		mockQuotaObserver := newMockQuotaObserver()
 
 		return TFS{
 			T:                   t,
 			mockDataNodeServers: []*mockDataNodeServer{mockDataNodeServerA, mockDataNodeServerB},
 			dataNodeAddrs:       []string{dataNodeAddrA, dataNodeAddrB},
 			mockRouteCache:      mockRouteCache,
+			mockQuotaObserver:   mockQuotaObserver,
 			dataNodeClients:     []pb.DataNodeClient{fetchDataNodeClient(dataNodeAddrA), fetchDataNodeClient(dataNodeAddrB)},
-			fs:                  filesystem.New(mockRouteCache),
+			fs:                  filesystem.New(mockRouteCache, hashing.V1, mockQuotaObserver),
 		}
 	})
 }
@@ -6,6 +6,8 @@
 package filesystem_test
 
 import (
+	"time"
+
 	pb "github.com/poy/loggrebutterfly/api/v1"
 	"golang.org/x/net/context"
 )
@@ -70,6 +72,15 @@ type mockMasterServer struct {
 		Ret0 chan *pb.AnalystsResponse
 		Ret1 chan error
 	}
+	DecisionsCalled chan bool
+	DecisionsInput  struct {
+		Arg0 chan context.Context
+		Arg1 chan *pb.DecisionsInfo
+	}
+	DecisionsOutput struct {
+		Ret0 chan *pb.DecisionsResponse
+		Ret1 chan error
+	}
 }
 
 func newMockMasterServer() *mockMasterServer {
@@ -84,6 +95,11 @@ func newMockMasterServer() *mockMasterServer {
 	m.AnalystsInput.Arg1 = make(chan *pb.AnalystsInfo, 100)
 	m.AnalystsOutput.Ret0 = make(chan *pb.AnalystsResponse, 100)
 	m.AnalystsOutput.Ret1 = make(chan error, 100)
+	m.DecisionsCalled = make(chan bool, 100)
+	m.DecisionsInput.Arg0 = make(chan context.Context, 100)
+	m.DecisionsInput.Arg1 = make(chan *pb.DecisionsInfo, 100)
+	m.DecisionsOutput.Ret0 = make(chan *pb.DecisionsResponse, 100)
+	m.DecisionsOutput.Ret1 = make(chan error, 100)
 	return m
 }
 func (m *mockMasterServer) Routes(arg0 context.Context, arg1 *pb.RoutesInfo) (*pb.RoutesResponse, error) {
@@ -98,6 +114,12 @@ func (m *mockMasterServer) Analysts(arg0 context.Context, arg1 *pb.AnalystsInfo)
 	m.AnalystsInput.Arg1 <- arg1
 	return <-m.AnalystsOutput.Ret0, <-m.AnalystsOutput.Ret1
 }
+func (m *mockMasterServer) Decisions(arg0 context.Context, arg1 *pb.DecisionsInfo) (*pb.DecisionsResponse, error) {
+	m.DecisionsCalled <- true
+	m.DecisionsInput.Arg0 <- arg0
+	m.DecisionsInput.Arg1 <- arg1
+	return <-m.DecisionsOutput.Ret0, <-m.DecisionsOutput.Ret1
+}
 
 type mockRouteCache struct {
 	ListCalled chan bool
@@ -138,3 +160,24 @@ func (m *mockRouteCache) FetchRoute(name string) (client pb.DataNodeClient, addr
 func (m *mockRouteCache) Reset() {
 	m.ResetCalled <- true
 }
+
+type mockQuotaObserver struct {
+	OverQuotaCalled chan bool
+	OverQuotaInput  struct {
+		SourceID   chan string
+		RetryAfter chan time.Duration
+	}
+}
+
+func newMockQuotaObserver() *mockQuotaObserver {
+	m := &mockQuotaObserver{}
+	m.OverQuotaCalled = make(chan bool, 100)
+	m.OverQuotaInput.SourceID = make(chan string, 100)
+	m.OverQuotaInput.RetryAfter = make(chan time.Duration, 100)
+	return m
+}
+func (m *mockQuotaObserver) OverQuota(sourceID string, retryAfter time.Duration) {
+	m.OverQuotaCalled <- true
+	m.OverQuotaInput.SourceID <- sourceID
+	m.OverQuotaInput.RetryAfter <- retryAfter
+}
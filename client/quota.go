@@ -0,0 +1,81 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/client/internal/filesystem"
+)
+
+// OverQuotaError is returned by Write while a source is over its rate
+// limit at the data node.
+type OverQuotaError struct {
+	SourceID   string
+	RetryAfter time.Duration
+}
+
+func (e *OverQuotaError) Error() string {
+	return fmt.Sprintf("source %s is over quota, retry after %s", e.SourceID, e.RetryAfter)
+}
+
+// RejectedError is returned by Write when the data node did not accept
+// the envelope.
+type RejectedError struct {
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("envelope was rejected: %s", e.Reason)
+}
+
+func rejected(r *filesystem.Rejection) error {
+	if r.Code == pb.RejectionCode_OVER_QUOTA {
+		return &OverQuotaError{SourceID: r.SourceID, RetryAfter: r.RetryAfter}
+	}
+
+	return &RejectedError{Reason: r.Reason}
+}
+
+type quota struct {
+	now func() time.Time
+
+	mu    sync.Mutex
+	until map[string]time.Time
+}
+
+func newQuota(now func() time.Time) *quota {
+	return &quota{
+		now:   now,
+		until: make(map[string]time.Time),
+	}
+}
+
+func (q *quota) OverQuota(sourceID string, retryAfter time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	until := q.now().Add(retryAfter)
+	if until.After(q.until[sourceID]) {
+		q.until[sourceID] = until
+	}
+}
+
+func (q *quota) check(sourceID string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	until, ok := q.until[sourceID]
+	if !ok {
+		return nil
+	}
+
+	now := q.now()
+	if !now.Before(until) {
+		delete(q.until, sourceID)
+		return nil
+	}
+
+	return &OverQuotaError{SourceID: sourceID, RetryAfter: until.Sub(now)}
+}
@@ -2,8 +2,12 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/bradylove/envstruct"
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
+	"github.com/poy/loggrebutterfly/datanode/internal/skew"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 )
 
 type Config struct {
@@ -11,15 +15,141 @@ type Config struct {
 	IntraAddr string `env:"INTRA_ADDR,required"`
 	NodeAddr  string `env:"NODE_ADDR,required"`
 	PprofAddr string `env:"PPROF_ADDR"`
+
+	// NodeID identifies the data node in hybrid logical clock values. It
+	// must be unique across data nodes. Defaults to ADDR.
+	NodeID string `env:"NODE_ID"`
+
+	// HashVersion is the hash scheme used to route envelopes. Only ranges
+	// created with the same version are written to.
+	HashVersion uint64 `env:"HASH_VERSION"`
+
+	// HotSourceList is a list of source_id:tag pairs. The tag is used as a
+	// secondary routing key so the source is spread across several ranges.
+	HotSourceList []string `env:"HOT_SOURCE_LIST"`
+
+	// DedupWindowSize is the number of idempotency keys remembered per
+	// range. 0 disables deduplication and clients stop writing envelopes
+	// that were not acknowledged again.
+	DedupWindowSize int `env:"DEDUP_WINDOW_SIZE"`
+
+	// DedupContentHash keys envelopes without an idempotency key or
+	// producer sequence by a hash of their content.
+	DedupContentHash bool `env:"DEDUP_CONTENT_HASH"`
+
+	// MaxPayloadSize is the largest envelope (in bytes) that is accepted.
+	MaxPayloadSize int `env:"MAX_PAYLOAD_SIZE"`
+
+	// SkewPolicyName is what happens to envelopes outside of the allowed
+	// clock skew: accept, clamp, reject or tag.
+	SkewPolicyName string `env:"SKEW_POLICY"`
+
+	// MaxFutureSkew is how far ahead of the node's clock an envelope's
+	// timestamp may be.
+	MaxFutureSkew time.Duration `env:"MAX_FUTURE_SKEW"`
+
+	// MaxPastSkew is how far behind the node's clock an envelope's
+	// timestamp may be. 0 allows any timestamp in the past.
+	MaxPastSkew time.Duration `env:"MAX_PAST_SKEW"`
+
+	// RateLimit is the default number of envelopes per second accepted
+	// from each source. 0 disables rate limiting.
+	RateLimit int `env:"RATE_LIMIT"`
+
+	// RateLimitBurst is the number of envelopes a source may send at once.
+	// Defaults to RATE_LIMIT.
+	RateLimitBurst int `env:"RATE_LIMIT_BURST"`
+
+	// RateLimitOverrideList is a list of source_id:rate:burst entries. A
+	// rate of 0 disables rate limiting for the source.
+	RateLimitOverrideList []string `env:"RATE_LIMIT_OVERRIDES"`
+
+	// PipelineConfig is the path to a JSON file with the ingest pipeline
+	// rules.
+	PipelineConfig string `env:"PIPELINE_CONFIG"`
+
+	// DeadLetterSize is the number of rejected payloads kept for
+	// inspection and replay.
+	DeadLetterSize int `env:"DEAD_LETTER_SIZE"`
+
+	// HTTPAddr is the address for the HTTP/JSON ingest endpoint. The
+	// endpoint is disabled if it is empty.
+	HTTPAddr string `env:"HTTP_ADDR"`
+
+	// OTLPGRPCAddr and OTLPHTTPAddr are the addresses for OTLP/gRPC and
+	// OTLP/HTTP logs and metrics. Each is disabled if it is empty.
+	OTLPGRPCAddr string `env:"OTLP_GRPC_ADDR"`
+	OTLPHTTPAddr string `env:"OTLP_HTTP_ADDR"`
+
+	// OTLPSourceIDAttribute is the OTLP attribute used as the source ID.
+	OTLPSourceIDAttribute string `env:"OTLP_SOURCE_ID_ATTRIBUTE"`
+
+	// PromWriteAddr is the address for the Prometheus remote write
+	// receiver. The receiver is disabled if it is empty.
+	PromWriteAddr string `env:"PROM_WRITE_ADDR"`
+
+	// PromSourceLabel is the Prometheus label used as the source ID.
+	PromSourceLabel string `env:"PROM_SOURCE_LABEL"`
+
+	// SyslogTCPAddr is the address for the syslog TCP listener. The
+	// listener is disabled if it is empty.
+	SyslogTCPAddr string `env:"SYSLOG_TCP_ADDR"`
+
+	// SyslogUDPAddr is the address for the syslog UDP listener. The
+	// listener is disabled if it is empty.
+	SyslogUDPAddr string `env:"SYSLOG_UDP_ADDR"`
+
+	// SyslogCertFile and SyslogKeyFile enable TLS for the syslog TCP
+	// listener.
+	SyslogCertFile string `env:"SYSLOG_CERT_FILE"`
+	SyslogKeyFile  string `env:"SYSLOG_KEY_FILE"`
+
+	HotSources map[string]string
+	SkewPolicy skew.Policy
+	RateLimits map[string]ratelimit.Limit
 }
 
 func Load() Config {
 	conf := Config{
-		PprofAddr: "localhost:0",
+		PprofAddr:             "localhost:0",
+		HashVersion:           hashing.CurrentVersion,
+		DedupWindowSize:       1000,
+		MaxPayloadSize:        64 * 1024,
+		SkewPolicyName:        "reject",
+		MaxFutureSkew:         24 * time.Hour,
+		DeadLetterSize:        1000,
+		OTLPSourceIDAttribute: "service.name",
+		PromSourceLabel:       "job",
 	}
 	if err := envstruct.Load(&conf); err != nil {
 		log.Fatalf("Unable to load config: %s", err)
 	}
 
+	if conf.NodeID == "" {
+		conf.NodeID = conf.Addr
+	}
+
+	hotSources, err := hashing.ParseHotSources(conf.HotSourceList)
+	if err != nil {
+		log.Fatalf("Invalid HOT_SOURCE_LIST: %s", err)
+	}
+	conf.HotSources = hotSources
+
+	policy, err := skew.ParsePolicy(conf.SkewPolicyName)
+	if err != nil {
+		log.Fatalf("Invalid SKEW_POLICY: %s", err)
+	}
+	conf.SkewPolicy = policy
+
+	rateLimits, err := ratelimit.ParseOverrides(conf.RateLimitOverrideList)
+	if err != nil {
+		log.Fatalf("Invalid RATE_LIMIT_OVERRIDES: %s", err)
+	}
+	conf.RateLimits = rateLimits
+
+	if (conf.SyslogCertFile == "") != (conf.SyslogKeyFile == "") {
+		log.Fatal("SYSLOG_CERT_FILE and SYSLOG_KEY_FILE must be set together")
+	}
+
 	return conf
 }
@@ -0,0 +1,109 @@
+package deadletter
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Letter is a payload that was rejected along with the reason.
+type Letter struct {
+	ID        uint64
+	Payload   []byte
+	Reason    string
+	Timestamp int64
+}
+
+// Buffer keeps the most recent rejected payloads. It is bounded, the
+// oldest letters are dropped once it is full.
+type Buffer struct {
+	size int
+
+	mu      sync.Mutex
+	letters []Letter
+	nextID  uint64
+}
+
+func NewBuffer(size int) *Buffer {
+	return &Buffer{
+		size:   size,
+		nextID: 1,
+	}
+}
+
+func (b *Buffer) Add(payload []byte, reason string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.letters = append(b.letters, Letter{
+		ID:        b.nextID,
+		Payload:   payload,
+		Reason:    reason,
+		Timestamp: time.Now().UnixNano(),
+	})
+	b.nextID++
+	b.truncate()
+}
+
+// Read returns up to limit letters starting at the given ID. A limit of 0
+// returns every letter.
+func (b *Buffer) Read(startID uint64, limit int) []Letter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var result []Letter
+	for _, l := range b.letters {
+		if l.ID < startID {
+			continue
+		}
+
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		result = append(result, l)
+	}
+
+	return result
+}
+
+// Take removes the letters with the given IDs and returns them.
+func (b *Buffer) Take(ids []uint64) []Letter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	m := make(map[uint64]bool)
+	for _, id := range ids {
+		m[id] = true
+	}
+
+	var taken []Letter
+	var kept []Letter
+	for _, l := range b.letters {
+		if m[l.ID] {
+			taken = append(taken, l)
+			continue
+		}
+		kept = append(kept, l)
+	}
+	b.letters = kept
+
+	return taken
+}
+
+// Return puts letters that were taken back into the buffer.
+func (b *Buffer) Return(letters []Letter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.letters = append(b.letters, letters...)
+	sort.Slice(b.letters, func(i, j int) bool {
+		return b.letters[i].ID < b.letters[j].ID
+	})
+	b.truncate()
+}
+
+func (b *Buffer) truncate() {
+	if len(b.letters) > b.size {
+		b.letters = b.letters[len(b.letters)-b.size:]
+	}
+}
@@ -0,0 +1,72 @@
+package deadletter_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/poy/loggrebutterfly/datanode/internal/deadletter"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestBuffer(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) (*testing.T, *deadletter.Buffer) {
+		b := deadletter.NewBuffer(3)
+		b.Add([]byte("a"), "reason-a")
+		b.Add([]byte("b"), "reason-b")
+		b.Add([]byte("c"), "reason-c")
+		return t, b
+	})
+
+	o.Spec("it returns the letters with their reasons", func(t *testing.T, b *deadletter.Buffer) {
+		letters := b.Read(0, 0)
+		Expect(t, letters).To(HaveLen(3))
+		Expect(t, letters[0].ID).To(Equal(uint64(1)))
+		Expect(t, letters[0].Payload).To(Equal([]byte("a")))
+		Expect(t, letters[0].Reason).To(Equal("reason-a"))
+	})
+
+	o.Spec("it reads from an ID with a limit", func(t *testing.T, b *deadletter.Buffer) {
+		letters := b.Read(2, 1)
+		Expect(t, letters).To(HaveLen(1))
+		Expect(t, letters[0].ID).To(Equal(uint64(2)))
+	})
+
+	o.Spec("it drops the oldest letters when full", func(t *testing.T, b *deadletter.Buffer) {
+		b.Add([]byte("d"), "reason-d")
+
+		letters := b.Read(0, 0)
+		Expect(t, letters).To(HaveLen(3))
+		Expect(t, letters[0].ID).To(Equal(uint64(2)))
+		Expect(t, letters[2].ID).To(Equal(uint64(4)))
+	})
+
+	o.Spec("it takes and returns letters", func(t *testing.T, b *deadletter.Buffer) {
+		taken := b.Take([]uint64{1, 3})
+		Expect(t, taken).To(HaveLen(2))
+		Expect(t, b.Read(0, 0)).To(HaveLen(1))
+
+		b.Return(taken[:1])
+		letters := b.Read(0, 0)
+		Expect(t, letters).To(HaveLen(2))
+		Expect(t, letters[0].ID).To(Equal(uint64(1)))
+		Expect(t, letters[1].ID).To(Equal(uint64(2)))
+	})
+}
@@ -0,0 +1,287 @@
+package dedup
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+	"github.com/poy/loggrebutterfly/internal/sequence"
+	"github.com/poy/petasos/router"
+)
+
+// KeyTag is the tag producers can set to give an envelope an explicit
+// idempotency key.
+const KeyTag = "idempotency_key"
+
+// Observer is told about every envelope that was written, duplicates that
+// were dropped are not observed.
+type Observer interface {
+	Observe(e *v2.Envelope)
+}
+
+// FileSystem wraps a router.FileSystem and drops envelopes whose
+// idempotency key was already written to the same range. Each range keeps
+// a bounded window of the most recent keys.
+type FileSystem struct {
+	fs          router.FileSystem
+	size        int
+	contentHash bool
+	observer    Observer
+
+	mu      sync.Mutex
+	windows map[string]*window
+}
+
+// NewFileSystem returns a FileSystem that remembers size keys per range. A
+// size of 0 disables deduplication. When contentHash is set, envelopes
+// without another key are keyed by a hash of their content. Every envelope
+// that is written is passed to the observer.
+func NewFileSystem(fs router.FileSystem, size int, contentHash bool, observer Observer) *FileSystem {
+	return &FileSystem{
+		fs:          fs,
+		size:        size,
+		contentHash: contentHash,
+		observer:    observer,
+		windows:     make(map[string]*window),
+	}
+}
+
+func (f *FileSystem) List() (files []string, err error) {
+	return f.fs.List()
+}
+
+func (f *FileSystem) Writer(name string) (writer router.Writer, err error) {
+	w, err := f.fs.Writer(name)
+	if err != nil {
+		return nil, err
+	}
+
+	dw := &dedupWriter{
+		Writer:      w,
+		contentHash: f.contentHash,
+		observer:    f.observer,
+	}
+	if f.size > 0 {
+		dw.window = f.window(name)
+	}
+
+	return dw, nil
+}
+
+// Duplicates returns how many envelopes were dropped for the given range.
+func (f *FileSystem) Duplicates(file string) uint64 {
+	f.mu.Lock()
+	w, ok := f.windows[file]
+	f.mu.Unlock()
+
+	if !ok {
+		return 0
+	}
+	return w.dropped()
+}
+
+// TotalDuplicates returns how many envelopes were dropped for every range.
+func (f *FileSystem) TotalDuplicates() (total uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, w := range f.windows {
+		total += w.dropped()
+	}
+	return total
+}
+
+func (f *FileSystem) window(name string) *window {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w, ok := f.windows[name]
+	if !ok {
+		w = newWindow(f.size)
+		f.windows[name] = w
+	}
+	return w
+}
+
+// Key returns the idempotency key of an envelope. An explicit KeyTag wins,
+// then the producer epoch and sequence number and finally, if contentHash
+// is set, a hash of the envelope without the tags added on ingest.
+func Key(data []byte, contentHash bool) (key string, ok bool) {
+	var e v2.Envelope
+	if err := proto.Unmarshal(data, &e); err != nil {
+		return "", false
+	}
+
+	return envelopeKey(&e, contentHash)
+}
+
+func envelopeKey(e *v2.Envelope, contentHash bool) (key string, ok bool) {
+	if k := e.Tags[KeyTag].GetText(); k != "" {
+		return "key:" + k, true
+	}
+
+	if epoch, seq, ok := sequence.Parse(e); ok {
+		return fmt.Sprintf("seq:%s:%q:%d:%d", e.SourceId, sequence.Stream(e), epoch, seq), true
+	}
+
+	if !contentHash {
+		return "", false
+	}
+
+	var buf proto.Buffer
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(ingest.Strip(e)); err != nil {
+		return "", false
+	}
+
+	h := fnv.New64a()
+	h.Write(buf.Bytes())
+	return fmt.Sprintf("content:%x", h.Sum64()), true
+}
+
+// BatchWriter is a router.Writer that writes several envelopes to the range
+// in one call.
+type BatchWriter interface {
+	router.Writer
+	WriteBatch(batch [][]byte) (err error)
+}
+
+type dedupWriter struct {
+	router.Writer
+	window      *window
+	contentHash bool
+	observer    Observer
+}
+
+func (w *dedupWriter) Write(data []byte) error {
+	return w.WriteBatch([][]byte{data})
+}
+
+// WriteBatch drops the duplicates in the batch and writes the rest. The
+// keys are only remembered and the envelopes only observed if the whole
+// batch was written.
+func (w *dedupWriter) WriteBatch(batch [][]byte) error {
+	var (
+		keep      [][]byte
+		keys      []string
+		envelopes []*v2.Envelope
+	)
+	inBatch := make(map[string]bool)
+	for _, data := range batch {
+		var e v2.Envelope
+		if err := proto.Unmarshal(data, &e); err != nil {
+			keep = append(keep, data)
+			continue
+		}
+
+		if key, ok := envelopeKey(&e, w.contentHash); ok && w.window != nil {
+			if inBatch[key] {
+				w.window.drop()
+				continue
+			}
+
+			if w.window.seen(key) {
+				continue
+			}
+
+			inBatch[key] = true
+			keys = append(keys, key)
+		}
+		keep = append(keep, data)
+		envelopes = append(envelopes, &e)
+	}
+
+	if len(keep) == 0 {
+		return nil
+	}
+
+	if err := writeBatch(w.Writer, keep); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		w.window.add(key)
+	}
+	for _, e := range envelopes {
+		w.observer.Observe(e)
+	}
+	return nil
+}
+
+func writeBatch(w router.Writer, batch [][]byte) error {
+	if bw, ok := w.(BatchWriter); ok {
+		return bw.WriteBatch(batch)
+	}
+
+	for _, data := range batch {
+		if err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type window struct {
+	mu    sync.Mutex
+	keys  map[string]bool
+	order []string
+	next  int
+	drops uint64
+}
+
+func newWindow(size int) *window {
+	return &window{
+		keys:  make(map[string]bool, size),
+		order: make([]string, 0, size),
+	}
+}
+
+// seen reports whether the key is in the window and counts it as a drop
+// if it is.
+func (w *window) seen(key string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.keys[key] {
+		return false
+	}
+
+	w.drops++
+	return true
+}
+
+func (w *window) drop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.drops++
+}
+
+func (w *window) add(key string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.keys[key] {
+		return
+	}
+	w.keys[key] = true
+
+	if len(w.order) < cap(w.order) {
+		w.order = append(w.order, key)
+		return
+	}
+
+	delete(w.keys, w.order[w.next])
+	w.order[w.next] = key
+	w.next = (w.next + 1) % len(w.order)
+}
+
+func (w *window) dropped() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.drops
+}
@@ -0,0 +1,223 @@
+package dedup_test
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/poy/eachers/testhelpers"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/dedup"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+	"github.com/poy/loggrebutterfly/internal/sequence"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TD struct {
+	*testing.T
+	fs                   *dedup.FileSystem
+	mockRouterFileSystem *mockRouterFileSystem
+	mockRouterWriter     *mockRouterWriter
+	mockObserver         *mockObserver
+}
+
+func TestFileSystem(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TD {
+		mockRouterFileSystem := newMockRouterFileSystem()
+		mockRouterWriter := newMockRouterWriter()
+		mockObserver := newMockObserver()
+		testhelpers.AlwaysReturn(mockRouterFileSystem.WriterOutput, mockRouterWriter, nil)
+
+		return TD{
+			T:                    t,
+			fs:                   dedup.NewFileSystem(mockRouterFileSystem, 2, true, mockObserver),
+			mockRouterFileSystem: mockRouterFileSystem,
+			mockRouterWriter:     mockRouterWriter,
+			mockObserver:         mockObserver,
+		}
+	})
+
+	o.Group("when the writes succeed", func() {
+		o.BeforeEach(func(t TD) TD {
+			testhelpers.AlwaysReturn(t.mockRouterWriter.WriteOutput.Err, nil)
+			return t
+		})
+
+		o.Spec("it drops duplicate envelopes", func(t TD) {
+			w, err := t.fs.Writer("some-file")
+			Expect(t, err == nil).To(BeTrue())
+
+			data := marshal(t, &v2.Envelope{SourceId: "some-id"})
+			Expect(t, w.Write(data) == nil).To(BeTrue())
+			Expect(t, w.Write(data) == nil).To(BeTrue())
+
+			Expect(t, len(t.mockRouterWriter.WriteCalled)).To(Equal(1))
+			Expect(t, t.fs.Duplicates("some-file")).To(Equal(uint64(1)))
+		})
+
+		o.Spec("it only observes the envelopes it writes", func(t TD) {
+			w, _ := t.fs.Writer("some-file")
+
+			data := marshal(t, stamped(&v2.Envelope{SourceId: "some-id"}))
+			w.Write(data)
+			w.Write(data)
+
+			Expect(t, len(t.mockObserver.ObserveCalled)).To(Equal(1))
+		})
+
+		o.Spec("it keeps the streams of a hot source apart", func(t TD) {
+			s := sequence.NewStamper(1, map[string]string{"some-id": "instance"})
+			hot := func(instance string) []byte {
+				return marshal(t, s.Stamp(&v2.Envelope{
+					SourceId: "some-id",
+					Tags: map[string]*v2.Value{
+						"instance": {Data: &v2.Value_Text{Text: instance}},
+					},
+				}))
+			}
+
+			w, _ := t.fs.Writer("some-file")
+			w.Write(hot("x"))
+			w.Write(hot("y"))
+
+			Expect(t, len(t.mockRouterWriter.WriteCalled)).To(Equal(2))
+			Expect(t, t.fs.Duplicates("some-file")).To(Equal(uint64(0)))
+		})
+
+		o.Spec("it drops duplicate envelopes in a batch", func(t TD) {
+			w, err := t.fs.Writer("some-file")
+			Expect(t, err == nil).To(BeTrue())
+			bw, ok := w.(dedup.BatchWriter)
+			Expect(t, ok).To(BeTrue())
+
+			a := marshal(t, &v2.Envelope{SourceId: "a"})
+			b := marshal(t, &v2.Envelope{SourceId: "b"})
+			Expect(t, bw.WriteBatch([][]byte{a, a, b}) == nil).To(BeTrue())
+			Expect(t, bw.WriteBatch([][]byte{b}) == nil).To(BeTrue())
+
+			Expect(t, len(t.mockRouterWriter.WriteCalled)).To(Equal(2))
+			Expect(t, t.fs.Duplicates("some-file")).To(Equal(uint64(2)))
+		})
+
+		o.Spec("it remembers keys across writers for a range", func(t TD) {
+			data := marshal(t, &v2.Envelope{SourceId: "some-id"})
+
+			w, _ := t.fs.Writer("some-file")
+			w.Write(data)
+			w, _ = t.fs.Writer("some-file")
+			w.Write(data)
+			w, _ = t.fs.Writer("some-other-file")
+			w.Write(data)
+
+			Expect(t, len(t.mockRouterWriter.WriteCalled)).To(Equal(2))
+			Expect(t, t.fs.Duplicates("some-file")).To(Equal(uint64(1)))
+			Expect(t, t.fs.Duplicates("some-other-file")).To(Equal(uint64(0)))
+			Expect(t, t.fs.TotalDuplicates()).To(Equal(uint64(1)))
+		})
+
+		o.Spec("it forgets keys that fall out of the window", func(t TD) {
+			w, _ := t.fs.Writer("some-file")
+			for i := 0; i < 3; i++ {
+				w.Write(marshal(t, &v2.Envelope{SourceId: fmt.Sprint(i)}))
+			}
+			w.Write(marshal(t, &v2.Envelope{SourceId: "0"}))
+
+			Expect(t, len(t.mockRouterWriter.WriteCalled)).To(Equal(4))
+			Expect(t, t.fs.Duplicates("some-file")).To(Equal(uint64(0)))
+		})
+	})
+
+	o.Group("when a write fails", func() {
+		o.BeforeEach(func(t TD) TD {
+			t.mockRouterWriter.WriteOutput.Err <- fmt.Errorf("some-error")
+			t.mockRouterWriter.WriteOutput.Err <- nil
+			return t
+		})
+
+		o.Spec("it does not remember the key", func(t TD) {
+			w, _ := t.fs.Writer("some-file")
+
+			data := marshal(t, &v2.Envelope{SourceId: "some-id"})
+			Expect(t, w.Write(data) == nil).To(BeFalse())
+			Expect(t, w.Write(data) == nil).To(BeTrue())
+
+			Expect(t, len(t.mockRouterWriter.WriteCalled)).To(Equal(2))
+		})
+	})
+}
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it prefers an explicit key", func(t *testing.T) {
+		e := stamped(&v2.Envelope{SourceId: "some-id"})
+		e.Tags[dedup.KeyTag] = &v2.Value{Data: &v2.Value_Text{Text: "some-key"}}
+
+		key, ok := dedup.Key(marshal(t, e), true)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, key).To(Equal("key:some-key"))
+	})
+
+	o.Spec("it uses the producer sequence", func(t *testing.T) {
+		a, _ := dedup.Key(marshal(t, stamped(&v2.Envelope{SourceId: "some-id"})), false)
+		b, ok := dedup.Key(marshal(t, stamped(&v2.Envelope{SourceId: "some-id"})), false)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, a).To(Not(Equal(b)))
+	})
+
+	o.Spec("it only hashes the content when enabled", func(t *testing.T) {
+		data := marshal(t, &v2.Envelope{SourceId: "some-id"})
+
+		_, ok := dedup.Key(data, false)
+		Expect(t, ok).To(BeFalse())
+
+		_, ok = dedup.Key(data, true)
+		Expect(t, ok).To(BeTrue())
+	})
+
+	o.Spec("it ignores the ingest tags when hashing the content", func(t *testing.T) {
+		a, _ := dedup.Key(marshal(t, &v2.Envelope{SourceId: "some-id"}), true)
+		b, _ := dedup.Key(marshal(t, &v2.Envelope{
+			SourceId: "some-id",
+			Tags: map[string]*v2.Value{
+				ingest.TimestampTag: {Data: &v2.Value_Integer{Integer: 99}},
+			},
+		}), true)
+		Expect(t, a).To(Equal(b))
+	})
+}
+
+var stamper = sequence.NewStamper(1, nil)
+
+func stamped(e *v2.Envelope) *v2.Envelope {
+	return stamper.Stamp(e)
+}
+
+func marshal(t testing.TB, e *v2.Envelope) []byte {
+	data, err := proto.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
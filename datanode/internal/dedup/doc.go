@@ -0,0 +1,13 @@
+package dedup
+
+import "github.com/poy/petasos/router"
+
+//go:generate hel
+
+type RouterFileSystem interface {
+	router.FileSystem
+}
+
+type RouterWriter interface {
+	router.Writer
+}
@@ -0,0 +1,94 @@
+// This file was generated by github.com/nelsam/hel.  Do not
+// edit this code by hand unless you *really* know what you're
+// doing.  Expect any changes made manually to be overwritten
+// the next time hel regenerates this file.
+
+package dedup_test
+
+import (
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/petasos/router"
+)
+
+type mockRouterFileSystem struct {
+	ListCalled chan bool
+	ListOutput struct {
+		File chan []string
+		Err  chan error
+	}
+	WriterCalled chan bool
+	WriterInput  struct {
+		Name chan string
+	}
+	WriterOutput struct {
+		Writer chan router.Writer
+		Err    chan error
+	}
+}
+
+func newMockRouterFileSystem() *mockRouterFileSystem {
+	m := &mockRouterFileSystem{}
+	m.ListCalled = make(chan bool, 100)
+	m.ListOutput.File = make(chan []string, 100)
+	m.ListOutput.Err = make(chan error, 100)
+	m.WriterCalled = make(chan bool, 100)
+	m.WriterInput.Name = make(chan string, 100)
+	m.WriterOutput.Writer = make(chan router.Writer, 100)
+	m.WriterOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockRouterFileSystem) List() (file []string, err error) {
+	m.ListCalled <- true
+	return <-m.ListOutput.File, <-m.ListOutput.Err
+}
+func (m *mockRouterFileSystem) Writer(name string) (writer router.Writer, err error) {
+	m.WriterCalled <- true
+	m.WriterInput.Name <- name
+	return <-m.WriterOutput.Writer, <-m.WriterOutput.Err
+}
+
+type mockRouterWriter struct {
+	WriteCalled chan bool
+	WriteInput  struct {
+		Data chan []byte
+	}
+	WriteOutput struct {
+		Err chan error
+	}
+	CloseCalled chan bool
+}
+
+func newMockRouterWriter() *mockRouterWriter {
+	m := &mockRouterWriter{}
+	m.WriteCalled = make(chan bool, 100)
+	m.WriteInput.Data = make(chan []byte, 100)
+	m.WriteOutput.Err = make(chan error, 100)
+	m.CloseCalled = make(chan bool, 100)
+	return m
+}
+func (m *mockRouterWriter) Write(data []byte) (err error) {
+	m.WriteCalled <- true
+	m.WriteInput.Data <- data
+	return <-m.WriteOutput.Err
+}
+func (m *mockRouterWriter) Close() {
+	m.CloseCalled <- true
+}
+
+type mockObserver struct {
+	ObserveCalled chan bool
+	ObserveInput  struct {
+		E chan *v2.Envelope
+	}
+}
+
+func newMockObserver() *mockObserver {
+	m := &mockObserver{}
+	m.ObserveCalled = make(chan bool, 100)
+	m.ObserveInput.E = make(chan *v2.Envelope, 100)
+	return m
+}
+func (m *mockObserver) Observe(e *v2.Envelope) {
+	m.ObserveCalled <- true
+	m.ObserveInput.E <- e
+}
@@ -90,7 +90,11 @@ func TestDataNode(t *testing.T) {
 
 	o.Spec("it writes to the talaria node", func(t TDN) {
 		e := &v2.Envelope{
-			SourceId: "some-id",
+			SourceId:  "some-id",
+			Timestamp: time.Now().UnixNano(),
+			Message: &v2.Envelope_Log{
+				Log: &v2.Log{Payload: []byte("some-log")},
+			},
 		}
 
 		data, err := proto.Marshal(e)
@@ -6,18 +6,21 @@ import (
 	"time"
 
 	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 	"github.com/poy/petasos/router"
 	pb "github.com/poy/talaria/api/v1"
 	"google.golang.org/grpc"
 )
 
 type FileSystem struct {
-	client pb.NodeClient
+	client      pb.NodeClient
+	hashVersion uint64
 }
 
-func New(addr string) *FileSystem {
+func New(addr string, hashVersion uint64) *FileSystem {
 	return &FileSystem{
-		client: setupClient(addr),
+		client:      setupClient(addr),
+		hashVersion: hashVersion,
 	}
 }
 
@@ -28,17 +31,11 @@ func (f *FileSystem) List() (file []string, err error) {
 		return nil, err
 	}
 
-	return resp.Names, nil
+	return hashing.FilterVersion(resp.Names, f.hashVersion), nil
 }
 
 func (f *FileSystem) Writer(name string) (writer router.Writer, err error) {
-	ctx, _ := context.WithTimeout(context.Background(), 5*time.Second)
-	sender, err := f.client.Write(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return nodeWriter{name: name, sender: sender}, nil
+	return nodeWriter{name: name, client: f.client}, nil
 }
 
 func (f *FileSystem) Reader(name string, startIndex uint64) (reader func() (*v1.ReadData, error), err error) {
@@ -72,16 +69,41 @@ func setupClient(addr string) pb.NodeClient {
 
 type nodeWriter struct {
 	name   string
-	sender pb.Node_WriteClient
+	client pb.NodeClient
 }
 
 func (w nodeWriter) Write(data []byte) (err error) {
-	return w.sender.Send(&pb.WriteDataPacket{
-		Name:    w.name,
-		Message: data,
-	})
+	return w.WriteBatch([][]byte{data})
+}
+
+// WriteBatch writes the batch to talaria on one stream and returns once
+// talaria has accepted it. Only then may the batch be acknowledged to the
+// producer.
+func (w nodeWriter) WriteBatch(batch [][]byte) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sender, err := w.client.Write(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, data := range batch {
+		err = sender.Send(&pb.WriteDataPacket{
+			Name:    w.name,
+			Message: data,
+		})
+		if err != nil {
+			break
+		}
+	}
+
+	if _, recvErr := sender.CloseAndRecv(); recvErr != nil {
+		return recvErr
+	}
+
+	return err
 }
 
 func (w nodeWriter) Close() {
-	w.sender.CloseAndRecv()
 }
@@ -2,6 +2,8 @@ package filesystem_test
 
 import (
 	"flag"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/poy/eachers/testhelpers"
 	"github.com/poy/loggrebutterfly/datanode/internal/filesystem"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -56,6 +59,27 @@ func TestFileSystemList(t *testing.T) {
 			Expect(t, list).To(Contain("a", "b", "c"))
 		})
 	})
+
+	o.Group("when the node has ranges from other hash versions", func() {
+		o.BeforeEach(func(t TFS) TFS {
+			testhelpers.AlwaysReturn(t.mockNodeServer.ListClustersOutput.Ret0, &pb.ListClustersResponse{
+				Names: []string{
+					`{"low":0,"high":9}`,
+					`{"low":0,"high":9,"hash_version":2}`,
+				},
+			})
+			close(t.mockNodeServer.ListClustersOutput.Ret1)
+			return t
+		})
+
+		o.Spec("it only returns ranges for its hash version", func(t TFS) {
+			list, err := t.fs.List()
+			Expect(t, err == nil).To(BeTrue())
+
+			Expect(t, list).To(HaveLen(1))
+			Expect(t, list).To(Contain(`{"low":0,"high":9}`))
+		})
+	})
 }
 
 func TestFileSystemWriter(t *testing.T) {
@@ -65,24 +89,82 @@ func TestFileSystemWriter(t *testing.T) {
 
 	setup(o)
 
-	o.Spec("it returns the list from the node", func(t TFS) {
+	o.Spec("it writes the data to the node", func(t TFS) {
 		defer close(t.mockNodeServer.WriteOutput.Ret0)
-		go func() {
-			writer, err := t.fs.Writer("some-name")
-			Expect(t, err == nil).To(BeTrue())
+		writer, err := t.fs.Writer("some-name")
+		Expect(t, err == nil).To(BeTrue())
+		go writer.Write([]byte("some-data"))
 
-			err = writer.Write([]byte("some-data"))
+		var rx pb.Node_WriteServer
+		Expect(t, t.mockNodeServer.WriteInput.Arg0).To(ViaPolling(
+			Chain(Receive(), Fetch(&rx)),
+		))
+
+		packet, err := rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, packet.Name).To(Equal("some-name"))
+		Expect(t, packet.Message).To(Equal([]byte("some-data")))
+	})
+
+	o.Spec("it writes a batch on one stream", func(t TFS) {
+		defer close(t.mockNodeServer.WriteOutput.Ret0)
+		writer, err := t.fs.Writer("some-name")
+		Expect(t, err == nil).To(BeTrue())
+		bw, ok := writer.(interface {
+			WriteBatch(batch [][]byte) error
+		})
+		Expect(t, ok).To(BeTrue())
+		go bw.WriteBatch([][]byte{[]byte("a"), []byte("b")})
+
+		var rx pb.Node_WriteServer
+		Expect(t, t.mockNodeServer.WriteInput.Arg0).To(ViaPolling(
+			Chain(Receive(), Fetch(&rx)),
+		))
+
+		for _, data := range []string{"a", "b"} {
+			packet, err := rx.Recv()
 			Expect(t, err == nil).To(BeTrue())
+			Expect(t, packet.Message).To(Equal([]byte(data)))
+		}
+		_, err = rx.Recv()
+		Expect(t, err).To(Equal(io.EOF))
+		Expect(t, t.mockNodeServer.WriteCalled).To(HaveLen(1))
+	})
+
+	o.Spec("it waits for the node to accept the data", func(t TFS) {
+		writer, err := t.fs.Writer("some-name")
+		Expect(t, err == nil).To(BeTrue())
+		errs := make(chan error, 1)
+		go func() {
+			errs <- writer.Write([]byte("some-data"))
 		}()
 
-		var writer pb.Node_WriteServer
+		var rx pb.Node_WriteServer
 		Expect(t, t.mockNodeServer.WriteInput.Arg0).To(ViaPolling(
-			Chain(Receive(), Fetch(&writer)),
+			Chain(Receive(), Fetch(&rx)),
+		))
+		_, err = rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, errs).To(Always(HaveLen(0)))
+
+		_, err = rx.Recv()
+		Expect(t, err).To(Equal(io.EOF))
+		Expect(t, rx.SendAndClose(new(pb.WriteResponse)) == nil).To(BeTrue())
+		t.mockNodeServer.WriteOutput.Ret0 <- nil
+
+		Expect(t, errs).To(ViaPolling(
+			Chain(Receive(), Equal(nil)),
 		))
+	})
+
+	o.Spec("it returns an error if the node does not accept the data", func(t TFS) {
+		testhelpers.AlwaysReturn(t.mockNodeServer.WriteOutput.Ret0, fmt.Errorf("some-error"))
 
-		packet, err := writer.Recv()
+		writer, err := t.fs.Writer("some-name")
 		Expect(t, err == nil).To(BeTrue())
-		Expect(t, packet.Message).To(Equal([]byte("some-data")))
+
+		err = writer.Write([]byte("some-data"))
+		Expect(t, err == nil).To(BeFalse())
 	})
 }
 
@@ -131,7 +213,7 @@ func setup(o *onpar.Onpar) {
 		addr, mockNodeServer := startMockNode()
 		return TFS{
 			T:              t,
-			fs:             filesystem.New(addr),
+			fs:             filesystem.New(addr, hashing.V1),
 			mockNodeServer: mockNodeServer,
 		}
 	})
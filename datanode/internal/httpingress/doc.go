@@ -0,0 +1,3 @@
+package httpingress
+
+//go:generate hel
@@ -0,0 +1,180 @@
+package httpingress
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
+)
+
+// maxBodySize is the largest request body that is read.
+const maxBodySize = 4 * 1024 * 1024
+
+type WriteFetcher interface {
+	Writer() (writer func(batch [][]byte) (errs []error), err error)
+}
+
+// LineError is the error for a single envelope. Line starts at 1.
+type LineError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// Response is the body returned for every write.
+type Response struct {
+	Written int         `json:"written"`
+	Errors  []LineError `json:"errors,omitempty"`
+}
+
+// Handler accepts envelopes in the JSON form of loggregator.v2.Envelope.
+// A request with the application/x-ndjson content type holds one envelope
+// per line. Any other request holds a single envelope.
+type Handler struct {
+	writer WriteFetcher
+}
+
+func New(writer WriteFetcher) *Handler {
+	return &Handler{writer: writer}
+}
+
+// Start serves the handler on /v2/envelopes.
+func Start(addr string, writer WriteFetcher) (actualAddr string, err error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v2/envelopes", New(writer))
+
+	go func() {
+		log.Println(http.Serve(lis, mux))
+	}()
+
+	return lis.Addr().String(), nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	lines, err := readLines(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writer, err := h.writer.Writer()
+	if err != nil {
+		log.Printf("Failed to fetch writer: %s", err)
+		http.Error(w, "unable to write envelopes", http.StatusServiceUnavailable)
+		return
+	}
+
+	var (
+		resp    Response
+		batch   [][]byte
+		numbers []int
+	)
+	for _, l := range lines {
+		var e v2.Envelope
+		if err := jsonpb.Unmarshal(bytes.NewReader(l.data), &e); err != nil {
+			resp.Errors = append(resp.Errors, LineError{Line: l.number, Error: err.Error()})
+			continue
+		}
+
+		data, err := proto.Marshal(&e)
+		if err != nil {
+			resp.Errors = append(resp.Errors, LineError{Line: l.number, Error: err.Error()})
+			continue
+		}
+		batch = append(batch, data)
+		numbers = append(numbers, l.number)
+	}
+
+	var retryAfter float64
+	overQuota := len(batch) > 0
+	if len(batch) > 0 {
+		for i, err := range writer(batch) {
+			if err == nil {
+				resp.Written++
+				continue
+			}
+
+			if e, ok := err.(*ratelimit.Error); ok {
+				retryAfter = math.Max(retryAfter, e.RetryAfter.Seconds())
+			} else {
+				overQuota = false
+			}
+			resp.Errors = append(resp.Errors, LineError{Line: numbers[i], Error: err.Error()})
+		}
+	}
+
+	status := http.StatusOK
+	switch {
+	case resp.Written > 0 || len(resp.Errors) == 0:
+	case overQuota && len(resp.Errors) == len(batch):
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter))))
+		status = http.StatusTooManyRequests
+	default:
+		status = http.StatusBadRequest
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+type line struct {
+	number int
+	data   []byte
+}
+
+// readLines splits an NDJSON body into its non-empty lines. Any other body
+// is a single line.
+func readLines(w http.ResponseWriter, r *http.Request) ([]line, error) {
+	body := http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "application/x-ndjson" {
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		return []line{{number: 1, data: data}}, nil
+	}
+
+	var lines []line
+	s := bufio.NewScanner(body)
+	s.Buffer(nil, maxBodySize)
+	for n := 1; s.Scan(); n++ {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+
+		data := make([]byte, len(s.Bytes()))
+		copy(data, s.Bytes())
+		lines = append(lines, line{number: n, data: data})
+	}
+
+	if err := s.Err(); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,153 @@
+package httpingress_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/poy/eachers/testhelpers"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/httpingress"
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TH struct {
+	*testing.T
+	handler *httpingress.Handler
+	batches chan [][]byte
+	errs    chan []error
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TH {
+		mockWriteFetcher := newMockWriteFetcher()
+		batches := make(chan [][]byte, 100)
+		errs := make(chan []error, 100)
+		writer := func(batch [][]byte) []error {
+			batches <- batch
+			select {
+			case e := <-errs:
+				return e
+			default:
+				return make([]error, len(batch))
+			}
+		}
+		testhelpers.AlwaysReturn(mockWriteFetcher.WriterOutput.Writer, writer)
+		close(mockWriteFetcher.WriterOutput.Err)
+
+		return TH{
+			T:       t,
+			handler: httpingress.New(mockWriteFetcher),
+			batches: batches,
+			errs:    errs,
+		}
+	})
+
+	o.Spec("it writes a single envelope", func(t TH) {
+		rec := post(t, "application/json", `{"sourceId": "some-id", "log": {"payload": "aGVsbG8="}}`)
+		Expect(t, rec.Code).To(Equal(http.StatusOK))
+		Expect(t, decode(t, rec)).To(Equal(httpingress.Response{Written: 1}))
+
+		envelopes := readBatch(t)
+		Expect(t, envelopes).To(HaveLen(1))
+		Expect(t, envelopes[0].SourceId).To(Equal("some-id"))
+		Expect(t, envelopes[0].GetLog().Payload).To(Equal([]byte("hello")))
+	})
+
+	o.Spec("it writes an NDJSON batch and reports errors per line", func(t TH) {
+		t.errs <- []error{nil, nil, fmt.Errorf("some-error")}
+		body := `{"sourceId": "a"}` + "\n\n" + `not-json` + "\n" + `{"sourceId": "b"}` + "\n" + `{"sourceId": "c"}`
+
+		rec := post(t, "application/x-ndjson", body)
+		Expect(t, rec.Code).To(Equal(http.StatusOK))
+
+		resp := decode(t, rec)
+		Expect(t, resp.Written).To(Equal(2))
+		Expect(t, resp.Errors).To(HaveLen(2))
+		Expect(t, resp.Errors[0].Line).To(Equal(3))
+		Expect(t, resp.Errors[1]).To(Equal(httpingress.LineError{Line: 5, Error: "some-error"}))
+
+		envelopes := readBatch(t)
+		Expect(t, envelopes).To(HaveLen(3))
+		Expect(t, envelopes[2].SourceId).To(Equal("c"))
+	})
+
+	o.Spec("it returns a bad request if nothing is written", func(t TH) {
+		rec := post(t, "application/json", `not-json`)
+		Expect(t, rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(t, decode(t, rec).Errors).To(HaveLen(1))
+	})
+
+	o.Spec("it returns too many requests if every envelope is over quota", func(t TH) {
+		t.errs <- []error{&ratelimit.Error{SourceID: "a", RetryAfter: 1500 * time.Millisecond}}
+
+		rec := post(t, "application/json", `{"sourceId": "a"}`)
+		Expect(t, rec.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(t, rec.Header().Get("Retry-After")).To(Equal("2"))
+	})
+
+	o.Spec("it only accepts POST", func(t TH) {
+		req := httptest.NewRequest(http.MethodGet, "/v2/envelopes", nil)
+		rec := httptest.NewRecorder()
+		t.handler.ServeHTTP(rec, req)
+		Expect(t, rec.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+}
+
+func post(t TH, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v2/envelopes", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	t.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode(t TH, rec *httptest.ResponseRecorder) httpingress.Response {
+	var resp httpingress.Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func readBatch(t TH) []*v2.Envelope {
+	var batch [][]byte
+	Expect(t, t.batches).To(ViaPolling(
+		Chain(Receive(), Fetch(&batch)),
+	))
+
+	var envelopes []*v2.Envelope
+	for _, data := range batch {
+		var e v2.Envelope
+		if err := proto.Unmarshal(data, &e); err != nil {
+			t.Fatal(err)
+		}
+		envelopes = append(envelopes, &e)
+	}
+	return envelopes
+}
@@ -0,0 +1,217 @@
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	common "github.com/poy/loggrebutterfly/api/otlp/common/v1"
+	logs "github.com/poy/loggrebutterfly/api/otlp/logs/v1"
+	metrics "github.com/poy/loggrebutterfly/api/otlp/metrics/v1"
+)
+
+// Tags set on envelopes converted from OTLP.
+const (
+	SeverityTextTag = "severity_text"
+	TraceIDTag      = "trace_id"
+	SpanIDTag       = "span_id"
+	ScopeTag        = "otel.scope.name"
+)
+
+// Converter turns OTLP logs and metrics into envelopes. Resource, scope
+// and record attributes become tags. The source ID is the value of the
+// source ID attribute, looked up on the record and then on the resource.
+type Converter struct {
+	sourceIDKey string
+}
+
+func NewConverter(sourceIDKey string) *Converter {
+	return &Converter{sourceIDKey: sourceIDKey}
+}
+
+// Logs converts each log record into a Log envelope. Records with a
+// severity of ERROR or worse are ERR logs.
+func (c *Converter) Logs(resourceLogs []*logs.ResourceLogs) []*v2.Envelope {
+	var envelopes []*v2.Envelope
+	for _, rl := range resourceLogs {
+		resourceAttrs := rl.GetResource().GetAttributes()
+		for _, sl := range rl.ScopeLogs {
+			for _, r := range sl.LogRecords {
+				e := c.envelope(resourceAttrs, sl.GetScope(), r.Attributes)
+
+				e.Timestamp = int64(r.TimeUnixNano)
+				if e.Timestamp == 0 {
+					e.Timestamp = int64(r.ObservedTimeUnixNano)
+				}
+
+				if r.SeverityText != "" {
+					e.Tags[SeverityTextTag] = text(r.SeverityText)
+				}
+				if len(r.TraceId) > 0 {
+					e.Tags[TraceIDTag] = text(hex.EncodeToString(r.TraceId))
+				}
+				if len(r.SpanId) > 0 {
+					e.Tags[SpanIDTag] = text(hex.EncodeToString(r.SpanId))
+				}
+
+				logType := v2.Log_OUT
+				if r.SeverityNumber >= logs.SeverityNumber_SEVERITY_NUMBER_ERROR {
+					logType = v2.Log_ERR
+				}
+				e.Message = &v2.Envelope_Log{
+					Log: &v2.Log{
+						Payload: []byte(stringValue(r.Body)),
+						Type:    logType,
+					},
+				}
+
+				envelopes = append(envelopes, e)
+			}
+		}
+	}
+
+	return envelopes
+}
+
+// Metrics converts each data point of a gauge or sum into an envelope.
+// Monotonic sums become Counters: cumulative sums set the total and delta
+// sums set the delta. Gauges and non-monotonic sums, which may go down,
+// become Gauges. Other metric types are skipped.
+func (c *Converter) Metrics(resourceMetrics []*metrics.ResourceMetrics) []*v2.Envelope {
+	var envelopes []*v2.Envelope
+	for _, rm := range resourceMetrics {
+		resourceAttrs := rm.GetResource().GetAttributes()
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				var (
+					points  []*metrics.NumberDataPoint
+					counter bool
+					delta   bool
+				)
+				switch data := m.Data.(type) {
+				case *metrics.Metric_Gauge:
+					points = data.Gauge.GetDataPoints()
+				case *metrics.Metric_Sum:
+					points = data.Sum.GetDataPoints()
+					counter = data.Sum.IsMonotonic
+					delta = data.Sum.AggregationTemporality == metrics.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+				default:
+					continue
+				}
+
+				for _, p := range points {
+					e := c.envelope(resourceAttrs, sm.GetScope(), p.Attributes)
+					e.Timestamp = int64(p.TimeUnixNano)
+
+					if counter {
+						e.Message = &v2.Envelope_Counter{Counter: counterValue(m.Name, p, delta)}
+					} else {
+						e.Message = &v2.Envelope_Gauge{
+							Gauge: &v2.Gauge{
+								Metrics: map[string]*v2.GaugeValue{
+									m.Name: {Unit: m.Unit, Value: pointValue(p)},
+								},
+							},
+						}
+					}
+
+					envelopes = append(envelopes, e)
+				}
+			}
+		}
+	}
+
+	return envelopes
+}
+
+func (c *Converter) envelope(resourceAttrs []*common.KeyValue, scope *common.InstrumentationScope, attrs []*common.KeyValue) *v2.Envelope {
+	tags := make(map[string]*v2.Value)
+	for _, kv := range resourceAttrs {
+		tags[kv.Key] = value(kv.Value)
+	}
+	if scope.GetName() != "" {
+		tags[ScopeTag] = text(scope.Name)
+	}
+	for _, kv := range attrs {
+		tags[kv.Key] = value(kv.Value)
+	}
+
+	return &v2.Envelope{
+		SourceId: c.sourceID(resourceAttrs, attrs),
+		Tags:     tags,
+	}
+}
+
+func (c *Converter) sourceID(resourceAttrs, attrs []*common.KeyValue) string {
+	for _, list := range [][]*common.KeyValue{attrs, resourceAttrs} {
+		for _, kv := range list {
+			if kv.Key == c.sourceIDKey {
+				return stringValue(kv.Value)
+			}
+		}
+	}
+	return ""
+}
+
+func counterValue(name string, p *metrics.NumberDataPoint, delta bool) *v2.Counter {
+	var n uint64
+	if v := pointValue(p); v > 0 {
+		n = uint64(v)
+	}
+
+	if delta {
+		return &v2.Counter{Name: name, Value: &v2.Counter_Delta{Delta: n}}
+	}
+	return &v2.Counter{Name: name, Value: &v2.Counter_Total{Total: n}}
+}
+
+func pointValue(p *metrics.NumberDataPoint) float64 {
+	switch v := p.Value.(type) {
+	case *metrics.NumberDataPoint_AsDouble:
+		return v.AsDouble
+	case *metrics.NumberDataPoint_AsInt:
+		return float64(v.AsInt)
+	}
+	return 0
+}
+
+func value(v *common.AnyValue) *v2.Value {
+	switch x := v.GetValue().(type) {
+	case *common.AnyValue_IntValue:
+		return &v2.Value{Data: &v2.Value_Integer{Integer: x.IntValue}}
+	case *common.AnyValue_DoubleValue:
+		return &v2.Value{Data: &v2.Value_Decimal{Decimal: x.DoubleValue}}
+	}
+	return text(stringValue(v))
+}
+
+// stringValue is the string form of an attribute value. Arrays and key
+// value lists are JSON encoded.
+func stringValue(v *common.AnyValue) string {
+	switch x := v.GetValue().(type) {
+	case nil:
+		return ""
+	case *common.AnyValue_StringValue:
+		return x.StringValue
+	case *common.AnyValue_BoolValue:
+		return strconv.FormatBool(x.BoolValue)
+	case *common.AnyValue_IntValue:
+		return strconv.FormatInt(x.IntValue, 10)
+	case *common.AnyValue_DoubleValue:
+		return strconv.FormatFloat(x.DoubleValue, 'g', -1, 64)
+	case *common.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(x.BytesValue)
+	}
+
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(v)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+func text(s string) *v2.Value {
+	return &v2.Value{Data: &v2.Value_Text{Text: s}}
+}
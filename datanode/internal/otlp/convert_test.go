@@ -0,0 +1,163 @@
+package otlp_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	common "github.com/poy/loggrebutterfly/api/otlp/common/v1"
+	logs "github.com/poy/loggrebutterfly/api/otlp/logs/v1"
+	metrics "github.com/poy/loggrebutterfly/api/otlp/metrics/v1"
+	resource "github.com/poy/loggrebutterfly/api/otlp/resource/v1"
+	"github.com/poy/loggrebutterfly/datanode/internal/otlp"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TC struct {
+	*testing.T
+	c *otlp.Converter
+}
+
+func TestConverter(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TC {
+		return TC{
+			T: t,
+			c: otlp.NewConverter("service.name"),
+		}
+	})
+
+	o.Spec("it converts log records", func(t TC) {
+		envelopes := t.c.Logs([]*logs.ResourceLogs{
+			{
+				Resource: &resource.Resource{
+					Attributes: []*common.KeyValue{
+						stringAttr("service.name", "checkout"),
+						{Key: "replicas", Value: &common.AnyValue{Value: &common.AnyValue_IntValue{IntValue: 3}}},
+					},
+				},
+				ScopeLogs: []*logs.ScopeLogs{
+					{
+						LogRecords: []*logs.LogRecord{
+							{
+								TimeUnixNano:   99,
+								SeverityNumber: logs.SeverityNumber_SEVERITY_NUMBER_ERROR,
+								SeverityText:   "error",
+								Body:           &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: "some-message"}},
+								Attributes:     []*common.KeyValue{stringAttr("user", "a")},
+								TraceId:        []byte{0xab, 0xcd},
+							},
+							{
+								ObservedTimeUnixNano: 100,
+								Attributes:           []*common.KeyValue{stringAttr("service.name", "override")},
+							},
+						},
+					},
+				},
+			},
+		})
+
+		Expect(t, envelopes).To(HaveLen(2))
+		Expect(t, envelopes[0].SourceId).To(Equal("checkout"))
+		Expect(t, envelopes[0].Timestamp).To(Equal(int64(99)))
+		Expect(t, envelopes[0].GetLog()).To(Equal(&v2.Log{Payload: []byte("some-message"), Type: v2.Log_ERR}))
+		Expect(t, envelopes[0].Tags["user"].GetText()).To(Equal("a"))
+		Expect(t, envelopes[0].Tags["replicas"].GetInteger()).To(Equal(int64(3)))
+		Expect(t, envelopes[0].Tags["severity_text"].GetText()).To(Equal("error"))
+		Expect(t, envelopes[0].Tags["trace_id"].GetText()).To(Equal("abcd"))
+
+		Expect(t, envelopes[1].SourceId).To(Equal("override"))
+		Expect(t, envelopes[1].Timestamp).To(Equal(int64(100)))
+		Expect(t, envelopes[1].GetLog().Type).To(Equal(v2.Log_OUT))
+	})
+
+	o.Spec("it converts sums and gauges", func(t TC) {
+		envelopes := t.c.Metrics([]*metrics.ResourceMetrics{
+			{
+				Resource: &resource.Resource{
+					Attributes: []*common.KeyValue{stringAttr("service.name", "checkout")},
+				},
+				ScopeMetrics: []*metrics.ScopeMetrics{
+					{
+						Metrics: []*metrics.Metric{
+							{
+								Name: "requests",
+								Data: &metrics.Metric_Sum{Sum: &metrics.Sum{
+									IsMonotonic:            true,
+									AggregationTemporality: metrics.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+									DataPoints:             []*metrics.NumberDataPoint{intPoint(1, 10)},
+								}},
+							},
+							{
+								Name: "errors",
+								Data: &metrics.Metric_Sum{Sum: &metrics.Sum{
+									IsMonotonic:            true,
+									AggregationTemporality: metrics.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+									DataPoints:             []*metrics.NumberDataPoint{intPoint(2, 3)},
+								}},
+							},
+							{
+								Name: "queue",
+								Data: &metrics.Metric_Sum{Sum: &metrics.Sum{
+									DataPoints: []*metrics.NumberDataPoint{intPoint(3, -2)},
+								}},
+							},
+							{
+								Name: "memory",
+								Unit: "By",
+								Data: &metrics.Metric_Gauge{Gauge: &metrics.Gauge{
+									DataPoints: []*metrics.NumberDataPoint{
+										{TimeUnixNano: 4, Value: &metrics.NumberDataPoint_AsDouble{AsDouble: 1.5}},
+									},
+								}},
+							},
+							{Name: "histogram"},
+						},
+					},
+				},
+			},
+		})
+
+		Expect(t, envelopes).To(HaveLen(4))
+		for _, e := range envelopes {
+			Expect(t, e.SourceId).To(Equal("checkout"))
+		}
+
+		Expect(t, envelopes[0].Timestamp).To(Equal(int64(1)))
+		Expect(t, envelopes[0].GetCounter()).To(Equal(&v2.Counter{Name: "requests", Value: &v2.Counter_Total{Total: 10}}))
+		Expect(t, envelopes[1].GetCounter()).To(Equal(&v2.Counter{Name: "errors", Value: &v2.Counter_Delta{Delta: 3}}))
+		Expect(t, envelopes[2].GetGauge().Metrics["queue"].Value).To(Equal(-2.0))
+		Expect(t, envelopes[3].GetGauge().Metrics["memory"]).To(Equal(&v2.GaugeValue{Unit: "By", Value: 1.5}))
+	})
+}
+
+func stringAttr(key, value string) *common.KeyValue {
+	return &common.KeyValue{
+		Key:   key,
+		Value: &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func intPoint(timestamp uint64, value int64) *metrics.NumberDataPoint {
+	return &metrics.NumberDataPoint{
+		TimeUnixNano: timestamp,
+		Value:        &metrics.NumberDataPoint_AsInt{AsInt: value},
+	}
+}
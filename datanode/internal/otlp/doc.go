@@ -0,0 +1,3 @@
+package otlp
+
+//go:generate hel
@@ -0,0 +1,76 @@
+package otlp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// hexFields are the bytes fields that OTLP/JSON encodes as hex instead of
+// the base64 of the protobuf JSON mapping.
+var hexFields = map[string]bool{
+	"traceId":      true,
+	"trace_id":     true,
+	"spanId":       true,
+	"span_id":      true,
+	"parentSpanId": true,
+}
+
+// unmarshalJSON reads an OTLP/JSON request. Trace and span IDs are hex
+// encoded and unknown fields are ignored, as the OTLP specification
+// requires.
+func unmarshalJSON(body []byte, req proto.Message) error {
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return err
+	}
+
+	if err := hexToBase64(v); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return err
+	}
+
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	return u.Unmarshal(&buf, req)
+}
+
+func hexToBase64(v interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			s, ok := value.(string)
+			if ok && hexFields[k] {
+				id, err := hex.DecodeString(s)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %s", k, err)
+				}
+				v[k] = base64.StdEncoding.EncodeToString(id)
+				continue
+			}
+
+			if err := hexToBase64(value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := hexToBase64(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
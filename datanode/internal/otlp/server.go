@@ -0,0 +1,217 @@
+package otlp
+
+import (
+	"io/ioutil"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	collogs "github.com/poy/loggrebutterfly/api/otlp/collector/logs/v1"
+	colmetrics "github.com/poy/loggrebutterfly/api/otlp/collector/metrics/v1"
+)
+
+// maxBodySize is the largest OTLP/HTTP request body that is read.
+const maxBodySize = 4 * 1024 * 1024
+
+type WriteFetcher interface {
+	Writer() (writer func(batch [][]byte) (errs []error), err error)
+}
+
+// Server implements the OTLP logs and metrics services. Envelopes that are
+// not written are reported as a partial success.
+type Server struct {
+	writer    WriteFetcher
+	converter *Converter
+}
+
+func New(writer WriteFetcher, converter *Converter) *Server {
+	return &Server{
+		writer:    writer,
+		converter: converter,
+	}
+}
+
+// Start serves OTLP/gRPC.
+func Start(addr string, s *Server) (actualAddr string, err error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	g := grpc.NewServer()
+	collogs.RegisterLogsServiceServer(g, logsService{s})
+	colmetrics.RegisterMetricsServiceServer(g, metricsService{s})
+	go func() {
+		log.Fatal(g.Serve(lis))
+	}()
+
+	return lis.Addr().String(), nil
+}
+
+// StartHTTP serves OTLP/HTTP on /v1/logs and /v1/metrics. Requests may be
+// binary protobuf or JSON.
+func StartHTTP(addr string, s *Server) (actualAddr string, err error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	go func() {
+		log.Println(http.Serve(lis, s))
+	}()
+
+	return lis.Addr().String(), nil
+}
+
+func (s *Server) ExportLogs(req *collogs.ExportLogsServiceRequest) (*collogs.ExportLogsServiceResponse, error) {
+	rejected, msg, err := s.write(s.converter.Logs(req.ResourceLogs))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &collogs.ExportLogsServiceResponse{}
+	if rejected > 0 {
+		resp.PartialSuccess = &collogs.ExportLogsPartialSuccess{
+			RejectedLogRecords: rejected,
+			ErrorMessage:       msg,
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) ExportMetrics(req *colmetrics.ExportMetricsServiceRequest) (*colmetrics.ExportMetricsServiceResponse, error) {
+	rejected, msg, err := s.write(s.converter.Metrics(req.ResourceMetrics))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &colmetrics.ExportMetricsServiceResponse{}
+	if rejected > 0 {
+		resp.PartialSuccess = &colmetrics.ExportMetricsPartialSuccess{
+			RejectedDataPoints: rejected,
+			ErrorMessage:       msg,
+		}
+	}
+	return resp, nil
+}
+
+// write returns the number of envelopes that were not written and the
+// first error.
+func (s *Server) write(envelopes []*v2.Envelope) (rejected int64, msg string, err error) {
+	var batch [][]byte
+	for _, e := range envelopes {
+		data, err := proto.Marshal(e)
+		if err != nil {
+			return 0, "", err
+		}
+		batch = append(batch, data)
+	}
+
+	if len(batch) == 0 {
+		return 0, "", nil
+	}
+
+	w, err := s.writer.Writer()
+	if err != nil {
+		return 0, "", err
+	}
+
+	for _, err := range w(batch) {
+		if err == nil {
+			continue
+		}
+
+		if rejected == 0 {
+			msg = err.Error()
+		}
+		rejected++
+	}
+
+	return rejected, msg, nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req, resp proto.Message
+	switch r.URL.Path {
+	case "/v1/logs":
+		req = &collogs.ExportLogsServiceRequest{}
+	case "/v1/metrics":
+		req = &colmetrics.ExportMetricsServiceRequest{}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	isJSON := mediaType == "application/json"
+	if isJSON {
+		err = unmarshalJSON(body, req)
+	} else {
+		err = proto.Unmarshal(body, req)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch req := req.(type) {
+	case *collogs.ExportLogsServiceRequest:
+		resp, err = s.ExportLogs(req)
+	case *colmetrics.ExportMetricsServiceRequest:
+		resp, err = s.ExportMetrics(req)
+	}
+	if err != nil {
+		log.Printf("Failed to export OTLP data: %s", err)
+		http.Error(w, "unable to write envelopes", http.StatusServiceUnavailable)
+		return
+	}
+
+	if isJSON {
+		w.Header().Set("Content-Type", "application/json")
+		(&jsonpb.Marshaler{}).Marshal(w, resp)
+		return
+	}
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(data)
+}
+
+type logsService struct {
+	s *Server
+}
+
+func (l logsService) Export(ctx context.Context, req *collogs.ExportLogsServiceRequest) (*collogs.ExportLogsServiceResponse, error) {
+	return l.s.ExportLogs(req)
+}
+
+type metricsService struct {
+	s *Server
+}
+
+func (m metricsService) Export(ctx context.Context, req *colmetrics.ExportMetricsServiceRequest) (*colmetrics.ExportMetricsServiceResponse, error) {
+	return m.s.ExportMetrics(req)
+}
@@ -0,0 +1,187 @@
+package otlp_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/poy/eachers/testhelpers"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	collogs "github.com/poy/loggrebutterfly/api/otlp/collector/logs/v1"
+	common "github.com/poy/loggrebutterfly/api/otlp/common/v1"
+	logs "github.com/poy/loggrebutterfly/api/otlp/logs/v1"
+	resource "github.com/poy/loggrebutterfly/api/otlp/resource/v1"
+	"github.com/poy/loggrebutterfly/datanode/internal/otlp"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+type TS struct {
+	*testing.T
+	s       *otlp.Server
+	batches chan [][]byte
+	errs    chan []error
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TS {
+		mockWriteFetcher := newMockWriteFetcher()
+		batches := make(chan [][]byte, 100)
+		errs := make(chan []error, 100)
+		writer := func(batch [][]byte) []error {
+			batches <- batch
+			select {
+			case e := <-errs:
+				return e
+			default:
+				return make([]error, len(batch))
+			}
+		}
+		testhelpers.AlwaysReturn(mockWriteFetcher.WriterOutput.Writer, writer)
+		close(mockWriteFetcher.WriterOutput.Err)
+
+		return TS{
+			T:       t,
+			s:       otlp.New(mockWriteFetcher, otlp.NewConverter("service.name")),
+			batches: batches,
+			errs:    errs,
+		}
+	})
+
+	o.Spec("it writes logs from OTLP/gRPC", func(t TS) {
+		addr, err := otlp.Start("127.0.0.1:0", t.s)
+		Expect(t, err == nil).To(BeTrue())
+
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		Expect(t, err == nil).To(BeTrue())
+		defer conn.Close()
+
+		t.errs <- []error{nil, fmt.Errorf("some-error")}
+		resp, err := collogs.NewLogsServiceClient(conn).Export(context.Background(), &collogs.ExportLogsServiceRequest{
+			ResourceLogs: []*logs.ResourceLogs{
+				{
+					Resource: &resource.Resource{
+						Attributes: []*common.KeyValue{stringAttr("service.name", "checkout")},
+					},
+					ScopeLogs: []*logs.ScopeLogs{
+						{LogRecords: []*logs.LogRecord{{TimeUnixNano: 1}, {TimeUnixNano: 2}}},
+					},
+				},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp.PartialSuccess).To(Equal(&collogs.ExportLogsPartialSuccess{
+			RejectedLogRecords: 1,
+			ErrorMessage:       "some-error",
+		}))
+
+		envelopes := readBatch(t)
+		Expect(t, envelopes).To(HaveLen(2))
+		Expect(t, envelopes[0].SourceId).To(Equal("checkout"))
+	})
+
+	o.Spec("it writes metrics from OTLP/HTTP JSON", func(t TS) {
+		body := `{"resourceMetrics": [{
+			"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "checkout"}}]},
+			"scopeMetrics": [{"metrics": [{"name": "memory", "gauge": {"dataPoints": [{"timeUnixNano": "5", "asDouble": 1.5}]}}]}]
+		}]}`
+		req := httptest.NewRequest(http.MethodPost, "/v1/metrics", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		t.s.ServeHTTP(rec, req)
+
+		Expect(t, rec.Code).To(Equal(http.StatusOK))
+		Expect(t, rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		envelopes := readBatch(t)
+		Expect(t, envelopes).To(HaveLen(1))
+		Expect(t, envelopes[0].Timestamp).To(Equal(int64(5)))
+		Expect(t, envelopes[0].GetGauge().Metrics["memory"].Value).To(Equal(1.5))
+	})
+
+	o.Spec("it reads hex trace and span IDs from OTLP/HTTP JSON", func(t TS) {
+		body := `{"resourceLogs": [{
+			"scopeLogs": [{"logRecords": [{
+				"timeUnixNano": "1",
+				"traceId": "5b8efff798038103d269b633813fc60c",
+				"spanId": "eee19b7ec3c1b174",
+				"someNewField": true
+			}]}]
+		}]}`
+		req := httptest.NewRequest(http.MethodPost, "/v1/logs", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		t.s.ServeHTTP(rec, req)
+
+		Expect(t, rec.Code).To(Equal(http.StatusOK))
+
+		envelopes := readBatch(t)
+		Expect(t, envelopes).To(HaveLen(1))
+		Expect(t, envelopes[0].Tags[otlp.TraceIDTag].GetText()).To(Equal("5b8efff798038103d269b633813fc60c"))
+		Expect(t, envelopes[0].Tags[otlp.SpanIDTag].GetText()).To(Equal("eee19b7ec3c1b174"))
+	})
+
+	o.Spec("it rejects trace IDs that are not hex", func(t TS) {
+		body := `{"resourceLogs": [{"scopeLogs": [{"logRecords": [{"traceId": "not-hex"}]}]}]}`
+		req := httptest.NewRequest(http.MethodPost, "/v1/logs", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		t.s.ServeHTTP(rec, req)
+
+		Expect(t, rec.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	o.Spec("it writes logs from OTLP/HTTP protobuf", func(t TS) {
+		data, err := proto.Marshal(&collogs.ExportLogsServiceRequest{
+			ResourceLogs: []*logs.ResourceLogs{
+				{ScopeLogs: []*logs.ScopeLogs{{LogRecords: []*logs.LogRecord{{TimeUnixNano: 1}}}}},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/logs", strings.NewReader(string(data)))
+		req.Header.Set("Content-Type", "application/x-protobuf")
+		rec := httptest.NewRecorder()
+		t.s.ServeHTTP(rec, req)
+
+		Expect(t, rec.Code).To(Equal(http.StatusOK))
+		var resp collogs.ExportLogsServiceResponse
+		Expect(t, proto.Unmarshal(rec.Body.Bytes(), &resp) == nil).To(BeTrue())
+		Expect(t, readBatch(t)).To(HaveLen(1))
+	})
+
+	o.Spec("it returns not found for unknown paths", func(t TS) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/traces", nil)
+		rec := httptest.NewRecorder()
+		t.s.ServeHTTP(rec, req)
+		Expect(t, rec.Code).To(Equal(http.StatusNotFound))
+	})
+}
+
+func readBatch(t TS) []*v2.Envelope {
+	var batch [][]byte
+	Expect(t, t.batches).To(ViaPolling(
+		Chain(Receive(), Fetch(&batch)),
+	))
+
+	var envelopes []*v2.Envelope
+	for _, data := range batch {
+		var e v2.Envelope
+		if err := proto.Unmarshal(data, &e); err != nil {
+			t.Fatal(err)
+		}
+		envelopes = append(envelopes, &e)
+	}
+	return envelopes
+}
@@ -0,0 +1,218 @@
+package pipeline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/jsonpb"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/envfilter"
+)
+
+// Config is the file format of the pipeline. Rules are applied in order.
+type Config struct {
+	Rules []RuleConfig `json:"rules"`
+}
+
+// RuleConfig is a single rule. The filter is an AnalystFilter in its JSON
+// form and has the same semantics as an analyst query. A rule without a
+// filter applies to every envelope. The actions of a rule are applied in
+// the order of the fields.
+type RuleConfig struct {
+	Filter json.RawMessage `json:"filter"`
+
+	// SetTags adds or replaces text tags.
+	SetTags map[string]string `json:"set_tags"`
+
+	// RenameTags moves the value of a tag to a new name. The renames are
+	// applied in order, so a tag can be renamed more than once.
+	RenameTags []RenameConfig `json:"rename_tags"`
+
+	// Redact replaces the matches of each pattern in log payloads.
+	Redact []RedactConfig `json:"redact"`
+
+	// Sample keeps the given ratio (0, 1] of envelopes. 0 keeps every
+	// envelope.
+	Sample float64 `json:"sample"`
+
+	// Drop drops every matching envelope.
+	Drop bool `json:"drop"`
+}
+
+type RenameConfig struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type RedactConfig struct {
+	Pattern     string `json:"pattern"`
+	Replacement string `json:"replacement"`
+}
+
+type rule struct {
+	filter     *envfilter.Filter
+	setTags    map[string]string
+	renameTags []RenameConfig
+	redact     []redaction
+	sample     float64
+	drop       bool
+}
+
+type redaction struct {
+	pattern     *regexp.Regexp
+	replacement []byte
+}
+
+// Pipeline transforms envelopes between the data node's server and the
+// router.
+type Pipeline struct {
+	dropped uint64
+	sampled uint64
+
+	rules  []rule
+	random func() float64
+}
+
+// Load reads the pipeline from a JSON file. An empty path returns a
+// pipeline without rules.
+func Load(path string, random func() float64) (*Pipeline, error) {
+	if path == "" {
+		return New(Config{}, random)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	return New(c, random)
+}
+
+// New returns a Pipeline for the config. random returns a number in
+// [0, 1) and is used to sample envelopes.
+func New(c Config, random func() float64) (*Pipeline, error) {
+	p := &Pipeline{random: random}
+	for i, rc := range c.Rules {
+		r, err := buildRule(rc)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %s", i, err)
+		}
+		p.rules = append(p.rules, r)
+	}
+
+	return p, nil
+}
+
+func buildRule(rc RuleConfig) (rule, error) {
+	var filter *v1.AnalystFilter
+	if len(rc.Filter) > 0 {
+		filter = new(v1.AnalystFilter)
+		if err := jsonpb.Unmarshal(bytes.NewReader(rc.Filter), filter); err != nil {
+			return rule{}, fmt.Errorf("invalid filter: %s", err)
+		}
+	}
+
+	f, err := envfilter.New(filter, envfilter.MatchAnySource())
+	if err != nil {
+		return rule{}, fmt.Errorf("invalid filter: %s", err)
+	}
+
+	if rc.Sample < 0 || rc.Sample > 1 {
+		return rule{}, fmt.Errorf("invalid sample ratio: %f", rc.Sample)
+	}
+
+	r := rule{
+		filter:     f,
+		setTags:    rc.SetTags,
+		renameTags: rc.RenameTags,
+		sample:     rc.Sample,
+		drop:       rc.Drop,
+	}
+
+	for _, rd := range rc.Redact {
+		pattern, err := regexp.Compile(rd.Pattern)
+		if err != nil {
+			return rule{}, fmt.Errorf("invalid redact pattern: %s", err)
+		}
+		r.redact = append(r.redact, redaction{
+			pattern:     pattern,
+			replacement: []byte(rd.Replacement),
+		})
+	}
+
+	return r, nil
+}
+
+// Transform applies the rules to a copy of the envelope. keep is false if
+// the envelope was dropped or sampled out.
+func (p *Pipeline) Transform(e *v2.Envelope) (transformed *v2.Envelope, keep bool) {
+	if len(p.rules) == 0 {
+		return e, true
+	}
+
+	e = copyEnvelope(e)
+	for _, r := range p.rules {
+		if !r.filter.Keep(e) {
+			continue
+		}
+
+		for k, v := range r.setTags {
+			e.Tags[k] = &v2.Value{Data: &v2.Value_Text{Text: v}}
+		}
+
+		for _, rn := range r.renameTags {
+			if v, ok := e.Tags[rn.From]; ok {
+				delete(e.Tags, rn.From)
+				e.Tags[rn.To] = v
+			}
+		}
+
+		if log := e.GetLog(); log != nil {
+			for _, rd := range r.redact {
+				log.Payload = rd.pattern.ReplaceAll(log.Payload, rd.replacement)
+			}
+		}
+
+		if r.sample > 0 && p.random() >= r.sample {
+			atomic.AddUint64(&p.sampled, 1)
+			return nil, false
+		}
+
+		if r.drop {
+			atomic.AddUint64(&p.dropped, 1)
+			return nil, false
+		}
+	}
+
+	return e, true
+}
+
+// Totals returns the number of envelopes that were dropped and sampled out.
+func (p *Pipeline) Totals() (dropped, sampled uint64) {
+	return atomic.LoadUint64(&p.dropped), atomic.LoadUint64(&p.sampled)
+}
+
+func copyEnvelope(e *v2.Envelope) *v2.Envelope {
+	c := *e
+	c.Tags = make(map[string]*v2.Value, len(e.Tags))
+	for k, v := range e.Tags {
+		c.Tags[k] = v
+	}
+
+	if log := e.GetLog(); log != nil {
+		l := *log
+		c.Message = &v2.Envelope_Log{Log: &l}
+	}
+
+	return &c
+}
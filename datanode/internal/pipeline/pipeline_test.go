@@ -0,0 +1,176 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/pipeline"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestPipeline(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it returns envelopes unchanged without rules", func(t *testing.T) {
+		p := newPipeline(t, `{}`, 0)
+		e := buildLog("some-id", "some-payload")
+
+		transformed, keep := p.Transform(e)
+		Expect(t, keep).To(BeTrue())
+		Expect(t, transformed).To(Equal(e))
+	})
+
+	o.Spec("it sets and renames tags", func(t *testing.T) {
+		p := newPipeline(t, `{"rules": [
+			{"set_tags": {"env": "prod"}, "rename_tags": [{"from": "old", "to": "new"}]}
+		]}`, 0)
+		e := buildLog("some-id", "some-payload")
+		e.Tags = map[string]*v2.Value{
+			"old": {Data: &v2.Value_Text{Text: "value"}},
+		}
+
+		transformed, keep := p.Transform(e)
+		Expect(t, keep).To(BeTrue())
+		Expect(t, transformed.Tags["env"].GetText()).To(Equal("prod"))
+		Expect(t, transformed.Tags["new"].GetText()).To(Equal("value"))
+		Expect(t, transformed.Tags).To(Not(HaveKey("old")))
+		Expect(t, e.Tags).To(HaveKey("old"))
+	})
+
+	o.Spec("it applies chained renames in order", func(t *testing.T) {
+		p := newPipeline(t, `{"rules": [
+			{"set_tags": {"b": "set"}, "rename_tags": [
+				{"from": "a", "to": "b"},
+				{"from": "b", "to": "c"}
+			]}
+		]}`, 0)
+		e := buildLog("some-id", "some-payload")
+		e.Tags = map[string]*v2.Value{
+			"a": {Data: &v2.Value_Text{Text: "value"}},
+		}
+
+		for i := 0; i < 10; i++ {
+			transformed, _ := p.Transform(e)
+			Expect(t, transformed.Tags).To(HaveLen(1))
+			Expect(t, transformed.Tags["c"].GetText()).To(Equal("value"))
+		}
+	})
+
+	o.Spec("it redacts log payloads", func(t *testing.T) {
+		p := newPipeline(t, `{"rules": [
+			{"redact": [{"pattern": "[a-z]+@[a-z.]+", "replacement": "[email]"}]}
+		]}`, 0)
+		e := buildLog("some-id", "sent to someone@example.com")
+
+		transformed, _ := p.Transform(e)
+		Expect(t, transformed.GetLog().Payload).To(Equal([]byte("sent to [email]")))
+		Expect(t, e.GetLog().Payload).To(Equal([]byte("sent to someone@example.com")))
+	})
+
+	o.Spec("it only applies rules to envelopes that match the filter", func(t *testing.T) {
+		p := newPipeline(t, `{"rules": [
+			{"filter": {"source_id": "noisy"}, "drop": true},
+			{"filter": {"log": {"regexp": "debug"}}, "drop": true}
+		]}`, 0)
+
+		_, keep := p.Transform(buildLog("noisy", "some-payload"))
+		Expect(t, keep).To(BeFalse())
+
+		_, keep = p.Transform(buildLog("some-id", "a debug line"))
+		Expect(t, keep).To(BeFalse())
+
+		_, keep = p.Transform(buildLog("some-id", "some-payload"))
+		Expect(t, keep).To(BeTrue())
+
+		dropped, _ := p.Totals()
+		Expect(t, dropped).To(Equal(uint64(2)))
+	})
+
+	o.Spec("it samples envelopes", func(t *testing.T) {
+		kept := newPipeline(t, `{"rules": [{"sample": 0.25}]}`, 0.1)
+		_, keep := kept.Transform(buildLog("some-id", "some-payload"))
+		Expect(t, keep).To(BeTrue())
+
+		sampled := newPipeline(t, `{"rules": [{"sample": 0.25}]}`, 0.5)
+		_, keep = sampled.Transform(buildLog("some-id", "some-payload"))
+		Expect(t, keep).To(BeFalse())
+
+		_, count := sampled.Totals()
+		Expect(t, count).To(Equal(uint64(1)))
+	})
+
+	o.Spec("it returns an error for invalid rules", func(t *testing.T) {
+		for _, c := range []string{
+			`{"rules": [{"filter": {"log": {"regexp": "["}}}]}`,
+			`{"rules": [{"filter": {"unknown": 1}}]}`,
+			`{"rules": [{"redact": [{"pattern": "["}]}]}`,
+			`{"rules": [{"sample": 2}]}`,
+		} {
+			var config pipeline.Config
+			Expect(t, json.Unmarshal([]byte(c), &config) == nil).To(BeTrue())
+
+			_, err := pipeline.New(config, nil)
+			Expect(t, err == nil).To(BeFalse())
+		}
+	})
+
+	o.Spec("it loads the rules from a file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "pipeline")
+		Expect(t, err == nil).To(BeTrue())
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "pipeline.json")
+		err = ioutil.WriteFile(path, []byte(`{"rules": [{"drop": true}]}`), 0600)
+		Expect(t, err == nil).To(BeTrue())
+
+		p, err := pipeline.Load(path, nil)
+		Expect(t, err == nil).To(BeTrue())
+		_, keep := p.Transform(buildLog("some-id", "some-payload"))
+		Expect(t, keep).To(BeFalse())
+
+		_, err = pipeline.Load(filepath.Join(dir, "missing.json"), nil)
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func newPipeline(t *testing.T, c string, random float64) *pipeline.Pipeline {
+	var config pipeline.Config
+	if err := json.Unmarshal([]byte(c), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := pipeline.New(config, func() float64 { return random })
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func buildLog(sourceID, payload string) *v2.Envelope {
+	return &v2.Envelope{
+		SourceId:  sourceID,
+		Timestamp: 99,
+		Message: &v2.Envelope_Log{
+			Log: &v2.Log{Payload: []byte(payload)},
+		},
+	}
+}
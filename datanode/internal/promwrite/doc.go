@@ -0,0 +1,3 @@
+package promwrite
+
+//go:generate hel
@@ -0,0 +1,26 @@
+// This file was generated by github.com/nelsam/hel.  Do not
+// edit this code by hand unless you *really* know what you're
+// doing.  Expect any changes made manually to be overwritten
+// the next time hel regenerates this file.
+
+package promwrite_test
+
+type mockWriteFetcher struct {
+	WriterCalled chan bool
+	WriterOutput struct {
+		Writer chan func(batch [][]byte) (errs []error)
+		Err    chan error
+	}
+}
+
+func newMockWriteFetcher() *mockWriteFetcher {
+	m := &mockWriteFetcher{}
+	m.WriterCalled = make(chan bool, 100)
+	m.WriterOutput.Writer = make(chan func(batch [][]byte) (errs []error), 100)
+	m.WriterOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockWriteFetcher) Writer() (writer func(batch [][]byte) (errs []error), err error) {
+	m.WriterCalled <- true
+	return <-m.WriterOutput.Writer, <-m.WriterOutput.Err
+}
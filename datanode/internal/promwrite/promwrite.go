@@ -0,0 +1,207 @@
+package promwrite
+
+import (
+	"io/ioutil"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	prompb "github.com/poy/loggrebutterfly/api/prompb"
+)
+
+// maxBodySize is the largest compressed request body that is read.
+const maxBodySize = 4 * 1024 * 1024
+
+// nameLabel is the label that holds the metric name.
+const nameLabel = "__name__"
+
+type WriteFetcher interface {
+	Writer() (writer func(batch [][]byte) (errs []error), err error)
+}
+
+// Handler is a Prometheus remote write receiver. Each sample is written as
+// an envelope with the series' labels as tags. Counters become Counter
+// envelopes with the sample as the total and everything else becomes a
+// Gauge. A series is a counter if the request's metadata says so or, when
+// there is no metadata for it, if its name ends in _total.
+type Handler struct {
+	writer      WriteFetcher
+	sourceLabel string
+}
+
+// New returns a Handler that uses the value of sourceLabel as the source
+// ID.
+func New(writer WriteFetcher, sourceLabel string) *Handler {
+	return &Handler{
+		writer:      writer,
+		sourceLabel: sourceLabel,
+	}
+}
+
+// Start serves the handler on /api/v1/write.
+func Start(addr string, h *Handler) (actualAddr string, err error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/v1/write", h)
+
+	go func() {
+		log.Println(http.Serve(lis, mux))
+	}()
+
+	return lis.Addr().String(), nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	compressed, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req prompb.WriteRequest
+	if err := proto.Unmarshal(data, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var batch [][]byte
+	for _, e := range h.Convert(&req) {
+		data, err := proto.Marshal(e)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		batch = append(batch, data)
+	}
+
+	if len(batch) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	writer, err := h.writer.Writer()
+	if err != nil {
+		log.Printf("Failed to fetch writer: %s", err)
+		http.Error(w, "unable to write samples", http.StatusServiceUnavailable)
+		return
+	}
+
+	// Prometheus retries the whole request on a 5xx and drops it on a 4xx.
+	// Samples that were written would be duplicated by a retry, so
+	// rejected samples are only logged.
+	var rejected int
+	for _, err := range writer(batch) {
+		if err != nil {
+			rejected++
+		}
+	}
+	if rejected > 0 {
+		log.Printf("Rejected %d of %d remote write samples", rejected, len(batch))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Convert turns each sample of the request into an envelope. Samples
+// from series without a name and staleness markers (NaN samples) are
+// skipped.
+func (h *Handler) Convert(req *prompb.WriteRequest) []*v2.Envelope {
+	types := make(map[string]prompb.MetricMetadata_MetricType)
+	for _, m := range req.Metadata {
+		types[m.MetricFamilyName] = m.Type
+	}
+
+	var envelopes []*v2.Envelope
+	for _, ts := range req.Timeseries {
+		var name, sourceID string
+		tags := make(map[string]*v2.Value)
+		for _, l := range ts.Labels {
+			switch l.Name {
+			case nameLabel:
+				name = l.Value
+				continue
+			case h.sourceLabel:
+				sourceID = l.Value
+			}
+			tags[l.Name] = &v2.Value{Data: &v2.Value_Text{Text: l.Value}}
+		}
+
+		if name == "" {
+			continue
+		}
+
+		counter := isCounter(name, types)
+		for _, s := range ts.Samples {
+			if math.IsNaN(s.Value) {
+				continue
+			}
+
+			e := &v2.Envelope{
+				Timestamp: s.Timestamp * 1e6,
+				SourceId:  sourceID,
+				Tags:      copyTags(tags),
+			}
+
+			if counter {
+				var total uint64
+				if s.Value > 0 {
+					total = uint64(s.Value)
+				}
+				e.Message = &v2.Envelope_Counter{
+					Counter: &v2.Counter{Name: name, Value: &v2.Counter_Total{Total: total}},
+				}
+			} else {
+				e.Message = &v2.Envelope_Gauge{
+					Gauge: &v2.Gauge{
+						Metrics: map[string]*v2.GaugeValue{
+							name: {Value: s.Value},
+						},
+					},
+				}
+			}
+
+			envelopes = append(envelopes, e)
+		}
+	}
+
+	return envelopes
+}
+
+func isCounter(name string, types map[string]prompb.MetricMetadata_MetricType) bool {
+	for _, family := range []string{name, strings.TrimSuffix(name, "_total")} {
+		if t, ok := types[family]; ok && t != prompb.MetricMetadata_UNKNOWN {
+			return t == prompb.MetricMetadata_COUNTER
+		}
+	}
+
+	return strings.HasSuffix(name, "_total")
+}
+
+func copyTags(tags map[string]*v2.Value) map[string]*v2.Value {
+	c := make(map[string]*v2.Value, len(tags))
+	for k, v := range tags {
+		c[k] = v
+	}
+	return c
+}
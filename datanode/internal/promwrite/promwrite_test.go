@@ -0,0 +1,148 @@
+package promwrite_test
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/poy/eachers/testhelpers"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	prompb "github.com/poy/loggrebutterfly/api/prompb"
+	"github.com/poy/loggrebutterfly/datanode/internal/promwrite"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TH struct {
+	*testing.T
+	h       *promwrite.Handler
+	batches chan [][]byte
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TH {
+		mockWriteFetcher := newMockWriteFetcher()
+		batches := make(chan [][]byte, 100)
+		writer := func(batch [][]byte) []error {
+			batches <- batch
+			return make([]error, len(batch))
+		}
+		testhelpers.AlwaysReturn(mockWriteFetcher.WriterOutput.Writer, writer)
+		close(mockWriteFetcher.WriterOutput.Err)
+
+		return TH{
+			T:       t,
+			h:       promwrite.New(mockWriteFetcher, "job"),
+			batches: batches,
+		}
+	})
+
+	o.Spec("it converts samples into gauges and counters", func(t TH) {
+		envelopes := t.h.Convert(&prompb.WriteRequest{
+			Timeseries: []*prompb.TimeSeries{
+				series("memory_bytes", 1.5, 2.5),
+				series("http_requests_total", 10),
+				series("errors", 3),
+				{Samples: []*prompb.Sample{{Value: 1}}},
+			},
+			Metadata: []*prompb.MetricMetadata{
+				{MetricFamilyName: "errors", Type: prompb.MetricMetadata_COUNTER},
+			},
+		})
+
+		Expect(t, envelopes).To(HaveLen(4))
+		for _, e := range envelopes {
+			Expect(t, e.SourceId).To(Equal("checkout"))
+			Expect(t, e.Tags["job"].GetText()).To(Equal("checkout"))
+			Expect(t, e.Tags["instance"].GetText()).To(Equal("a:9090"))
+			Expect(t, e.Tags).To(HaveLen(2))
+		}
+
+		Expect(t, envelopes[0].Timestamp).To(Equal(int64(1000000)))
+		Expect(t, envelopes[0].GetGauge().Metrics["memory_bytes"].Value).To(Equal(1.5))
+		Expect(t, envelopes[1].Timestamp).To(Equal(int64(2000000)))
+		Expect(t, envelopes[1].GetGauge().Metrics["memory_bytes"].Value).To(Equal(2.5))
+		Expect(t, envelopes[2].GetCounter()).To(Equal(&v2.Counter{
+			Name:  "http_requests_total",
+			Value: &v2.Counter_Total{Total: 10},
+		}))
+		Expect(t, envelopes[3].GetCounter().GetTotal()).To(Equal(uint64(3)))
+	})
+
+	o.Spec("it skips staleness markers", func(t TH) {
+		envelopes := t.h.Convert(&prompb.WriteRequest{
+			Timeseries: []*prompb.TimeSeries{
+				series("memory_bytes", 1.5, math.NaN()),
+				series("http_requests_total", math.NaN()),
+			},
+		})
+
+		Expect(t, envelopes).To(HaveLen(1))
+		Expect(t, envelopes[0].GetGauge().Metrics["memory_bytes"].Value).To(Equal(1.5))
+	})
+
+	o.Spec("it writes snappy compressed requests", func(t TH) {
+		data, err := proto.Marshal(&prompb.WriteRequest{
+			Timeseries: []*prompb.TimeSeries{series("memory_bytes", 1.5)},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/write", bytes.NewReader(snappy.Encode(nil, data)))
+		rec := httptest.NewRecorder()
+		t.h.ServeHTTP(rec, req)
+		Expect(t, rec.Code).To(Equal(http.StatusNoContent))
+
+		var batch [][]byte
+		Expect(t, t.batches).To(ViaPolling(
+			Chain(Receive(), Fetch(&batch)),
+		))
+		Expect(t, batch).To(HaveLen(1))
+
+		var e v2.Envelope
+		Expect(t, proto.Unmarshal(batch[0], &e) == nil).To(BeTrue())
+		Expect(t, e.GetGauge().Metrics["memory_bytes"].Value).To(Equal(1.5))
+	})
+
+	o.Spec("it rejects requests that are not snappy compressed", func(t TH) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/write", bytes.NewReader([]byte("invalid")))
+		rec := httptest.NewRecorder()
+		t.h.ServeHTTP(rec, req)
+		Expect(t, rec.Code).To(Equal(http.StatusBadRequest))
+	})
+}
+
+func series(name string, values ...float64) *prompb.TimeSeries {
+	ts := &prompb.TimeSeries{
+		Labels: []*prompb.Label{
+			{Name: "__name__", Value: name},
+			{Name: "job", Value: "checkout"},
+			{Name: "instance", Value: "a:9090"},
+		},
+	}
+	for i, v := range values {
+		ts.Samples = append(ts.Samples, &prompb.Sample{Value: v, Timestamp: int64(i + 1)})
+	}
+	return ts
+}
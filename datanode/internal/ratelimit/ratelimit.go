@@ -0,0 +1,194 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/poy/petasos/router"
+)
+
+// Limit is a token bucket. Rate is the number of envelopes per second and
+// Burst is the size of the bucket. A Rate of 0 is unlimited.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Error is returned for envelopes from a source that is over its quota.
+type Error struct {
+	SourceID   string
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("source %s is over quota, retry after %s", e.SourceID, e.RetryAfter)
+}
+
+// ParseOverrides parses source_id:rate:burst entries.
+func ParseOverrides(list []string) (map[string]Limit, error) {
+	m := make(map[string]Limit)
+	for _, s := range list {
+		parts := strings.Split(s, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid rate limit override (expected source_id:rate:burst): %s", s)
+		}
+
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid rate for %s: %s", parts[0], parts[1])
+		}
+
+		burst, err := strconv.Atoi(parts[2])
+		if err != nil || burst < 0 {
+			return nil, fmt.Errorf("invalid burst for %s: %s", parts[0], parts[2])
+		}
+
+		m[parts[0]] = Limit{Rate: rate, Burst: burst}
+	}
+
+	return m, nil
+}
+
+// sweepInterval is how often the buckets that have refilled are dropped.
+const sweepInterval = time.Minute
+
+// Limiter keeps a token bucket per source. A full bucket is the same as a
+// new one, so buckets are dropped once they have refilled.
+type Limiter struct {
+	defaultLimit Limit
+	overrides    map[string]Limit
+	now          func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	throttled map[router.RangeName]uint64
+	total     uint64
+	lastSweep time.Time
+}
+
+func New(defaultLimit Limit, overrides map[string]Limit, now func() time.Time) *Limiter {
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		overrides:    overrides,
+		now:          now,
+		buckets:      make(map[string]*bucket),
+		throttled:    make(map[router.RangeName]uint64),
+		lastSweep:    now(),
+	}
+}
+
+// Allow takes a token from the source's bucket. It returns an *Error if the
+// bucket is empty. Throttled envelopes are counted for the range they were
+// written to.
+func (l *Limiter) Allow(sourceID string, rn router.RangeName) error {
+	limit, ok := l.overrides[sourceID]
+	if !ok {
+		limit = l.defaultLimit
+	}
+
+	if limit.Rate == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[sourceID]
+	if !ok {
+		b = newBucket(limit, now)
+		l.buckets[sourceID] = b
+	}
+
+	if retryAfter, ok := b.take(now); !ok {
+		l.throttled[rn]++
+		l.total++
+		return &Error{SourceID: sourceID, RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// Buckets returns the number of sources that have a token bucket.
+func (l *Limiter) Buckets() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.buckets)
+}
+
+// sweep drops the buckets that have refilled. It must be called with the
+// lock held.
+func (l *Limiter) sweep(now time.Time) {
+	for id, b := range l.buckets {
+		if b.full(now) {
+			delete(l.buckets, id)
+		}
+	}
+	l.lastSweep = now
+}
+
+// Throttled returns the number of envelopes that were throttled for the
+// given range.
+func (l *Limiter) Throttled(rn router.RangeName) uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.throttled[rn]
+}
+
+// TotalThrottled returns the number of throttled envelopes across every
+// source.
+func (l *Limiter) TotalThrottled() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.total
+}
+
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(limit Limit, now time.Time) *bucket {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(limit.Rate))
+	}
+
+	return &bucket{
+		rate:   limit.Rate,
+		burst:  burst,
+		tokens: burst,
+		last:   now,
+	}
+}
+
+func (b *bucket) take(now time.Time) (retryAfter time.Duration, ok bool) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / b.rate * float64(time.Second)), false
+	}
+
+	b.tokens--
+	return 0, true
+}
+
+func (b *bucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
@@ -0,0 +1,148 @@
+package ratelimit_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+	"github.com/poy/petasos/router"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TL struct {
+	*testing.T
+	now     *time.Time
+	limiter *ratelimit.Limiter
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TL {
+		now := time.Unix(1000, 0)
+		return TL{
+			T:   t,
+			now: &now,
+			limiter: ratelimit.New(
+				ratelimit.Limit{Rate: 1, Burst: 2},
+				map[string]ratelimit.Limit{
+					"unlimited": {},
+					"fast":      {Rate: 10, Burst: 5},
+				},
+				func() time.Time { return now },
+			),
+		}
+	})
+
+	o.Spec("it allows a burst and then throttles", func(t TL) {
+		Expect(t, t.limiter.Allow("some-id", someRange) == nil).To(BeTrue())
+		Expect(t, t.limiter.Allow("some-id", someRange) == nil).To(BeTrue())
+
+		err := t.limiter.Allow("some-id", someRange)
+		Expect(t, err == nil).To(BeFalse())
+
+		quotaErr, ok := err.(*ratelimit.Error)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, quotaErr.SourceID).To(Equal("some-id"))
+		Expect(t, quotaErr.RetryAfter).To(Equal(time.Second))
+	})
+
+	o.Spec("it refills the bucket over time", func(t TL) {
+		t.limiter.Allow("some-id", someRange)
+		t.limiter.Allow("some-id", someRange)
+		*t.now = t.now.Add(time.Second)
+
+		Expect(t, t.limiter.Allow("some-id", someRange) == nil).To(BeTrue())
+		Expect(t, t.limiter.Allow("some-id", someRange) == nil).To(BeFalse())
+	})
+
+	o.Spec("it keeps a bucket per source", func(t TL) {
+		t.limiter.Allow("some-id", someRange)
+		t.limiter.Allow("some-id", someRange)
+
+		Expect(t, t.limiter.Allow("other-id", otherRange) == nil).To(BeTrue())
+	})
+
+	o.Spec("it drops buckets that have refilled", func(t TL) {
+		t.limiter.Allow("some-id", someRange)
+		t.limiter.Allow("some-id", someRange)
+		t.limiter.Allow("fast", someRange)
+		Expect(t, t.limiter.Buckets()).To(Equal(2))
+
+		*t.now = t.now.Add(time.Minute + time.Second)
+		t.limiter.Allow("other-id", otherRange)
+		Expect(t, t.limiter.Buckets()).To(Equal(1))
+
+		Expect(t, t.limiter.Allow("some-id", someRange) == nil).To(BeTrue())
+		Expect(t, t.limiter.Allow("some-id", someRange) == nil).To(BeTrue())
+		Expect(t, t.limiter.Allow("some-id", someRange) == nil).To(BeFalse())
+	})
+
+	o.Spec("it uses the overrides", func(t TL) {
+		for i := 0; i < 100; i++ {
+			Expect(t, t.limiter.Allow("unlimited", someRange) == nil).To(BeTrue())
+		}
+
+		for i := 0; i < 5; i++ {
+			Expect(t, t.limiter.Allow("fast", someRange) == nil).To(BeTrue())
+		}
+		Expect(t, t.limiter.Allow("fast", someRange) == nil).To(BeFalse())
+	})
+
+	o.Spec("it counts throttled envelopes per range", func(t TL) {
+		for i := 0; i < 3; i++ {
+			t.limiter.Allow("some-id", someRange)
+		}
+		for i := 0; i < 4; i++ {
+			t.limiter.Allow("other-id", otherRange)
+		}
+
+		Expect(t, t.limiter.Throttled(someRange)).To(Equal(uint64(1)))
+		Expect(t, t.limiter.Throttled(otherRange)).To(Equal(uint64(2)))
+		Expect(t, t.limiter.TotalThrottled()).To(Equal(uint64(3)))
+	})
+}
+
+var (
+	someRange  = router.RangeName{Low: 0, High: 10}
+	otherRange = router.RangeName{Low: 11, High: 100}
+)
+
+func TestParseOverrides(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it parses source_id:rate:burst", func(t *testing.T) {
+		m, err := ratelimit.ParseOverrides([]string{"a:1.5:10", "b:0:0"})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, m).To(Equal(map[string]ratelimit.Limit{
+			"a": {Rate: 1.5, Burst: 10},
+			"b": {},
+		}))
+	})
+
+	o.Spec("it returns an error for invalid entries", func(t *testing.T) {
+		for _, s := range []string{"a", "a:1", ":1:1", "a:x:1", "a:1:x", "a:-1:1"} {
+			_, err := ratelimit.ParseOverrides([]string{s})
+			Expect(t, err == nil).To(BeFalse())
+		}
+	})
+}
@@ -12,7 +12,7 @@ import (
 type mockWriteFetcher struct {
 	WriterCalled chan bool
 	WriterOutput struct {
-		Writer chan func(data []byte) (err error)
+		Writer chan func(batch [][]byte) (errs []error)
 		Err    chan error
 	}
 }
@@ -20,11 +20,11 @@ type mockWriteFetcher struct {
 func newMockWriteFetcher() *mockWriteFetcher {
 	m := &mockWriteFetcher{}
 	m.WriterCalled = make(chan bool, 100)
-	m.WriterOutput.Writer = make(chan func(data []byte) (err error), 100)
+	m.WriterOutput.Writer = make(chan func(batch [][]byte) (errs []error), 100)
 	m.WriterOutput.Err = make(chan error, 100)
 	return m
 }
-func (m *mockWriteFetcher) Writer() (writer func(data []byte) (err error), err error) {
+func (m *mockWriteFetcher) Writer() (writer func(batch [][]byte) (errs []error), err error) {
 	m.WriterCalled <- true
 	return <-m.WriterOutput.Writer, <-m.WriterOutput.Err
 }
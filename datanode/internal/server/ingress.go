@@ -0,0 +1,126 @@
+package server
+
+import (
+	"io"
+	"log"
+
+	"golang.org/x/net/context"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	pb "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ingressServer implements the loggregator v2 Ingress service so existing
+// agents can write to the data node. Envelopes are written through the same
+// path as DataNode.Write. The Ingress service has no way to report single
+// rejections, so a batch with rejected envelopes fails with a status the
+// agent can retry on: Unavailable if a write failed, ResourceExhausted if a
+// source is over quota and InvalidArgument otherwise.
+type ingressServer struct {
+	writer WriteFetcher
+}
+
+func (s *ingressServer) Sender(rx v2.Ingress_SenderServer) error {
+	w, err := s.writer.Writer()
+	if err != nil {
+		return err
+	}
+
+	for {
+		e, err := rx.Recv()
+		if err == io.EOF {
+			return rx.SendAndClose(&v2.IngressResponse{})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := writeEnvelopes(w, []*v2.Envelope{e}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *ingressServer) BatchSender(rx v2.Ingress_BatchSenderServer) error {
+	w, err := s.writer.Writer()
+	if err != nil {
+		return err
+	}
+
+	for {
+		b, err := rx.Recv()
+		if err == io.EOF {
+			return rx.SendAndClose(&v2.BatchSenderResponse{})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := writeEnvelopes(w, b.Batch); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *ingressServer) Send(ctx context.Context, b *v2.EnvelopeBatch) (*v2.SendResponse, error) {
+	w, err := s.writer.Writer()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeEnvelopes(w, b.Batch); err != nil {
+		return nil, err
+	}
+	return &v2.SendResponse{}, nil
+}
+
+// writeEnvelopes writes the envelopes and returns a status error if any of
+// them were not written.
+func writeEnvelopes(w func(batch [][]byte) []error, envelopes []*v2.Envelope) error {
+	var (
+		batch    [][]byte
+		failures []error
+	)
+	for _, e := range envelopes {
+		data, err := proto.Marshal(e)
+		if err != nil {
+			log.Printf("Failed to marshal envelope: %s", err)
+			failures = append(failures, &validation.Error{Reason: err.Error()})
+			continue
+		}
+		batch = append(batch, data)
+	}
+
+	if len(batch) > 0 {
+		for _, err := range w(batch) {
+			if err != nil {
+				log.Printf("Rejecting envelope from ingress: %s", err)
+				failures = append(failures, err)
+			}
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	code := codes.InvalidArgument
+	for _, err := range failures {
+		switch rejection(0, err).Code {
+		case pb.RejectionCode_WRITE_FAILED:
+			code = codes.Unavailable
+		case pb.RejectionCode_OVER_QUOTA:
+			if code != codes.Unavailable {
+				code = codes.ResourceExhausted
+			}
+		}
+	}
+
+	return status.Errorf(code, "%d of %d envelopes were not written: %s", len(failures), len(envelopes), failures[0])
+}
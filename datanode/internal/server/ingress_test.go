@@ -0,0 +1,182 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/poy/eachers/testhelpers"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
+	"github.com/poy/loggrebutterfly/datanode/internal/server"
+
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+type TI struct {
+	*testing.T
+	client v2.IngressClient
+
+	batches chan [][]byte
+	errs    chan error
+}
+
+func TestIngress(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TI {
+		mockWriteFetcher := newMockWriteFetcher()
+		mockReadFetcher := newMockReadFetcher()
+
+		batches := make(chan [][]byte, 100)
+		errs := make(chan error, 100)
+		writer := func(batch [][]byte) []error {
+			batches <- batch
+			result := make([]error, len(batch))
+			for i := range result {
+				select {
+				case result[i] = <-errs:
+				default:
+				}
+			}
+			return result
+		}
+		testhelpers.AlwaysReturn(mockWriteFetcher.WriterOutput.Writer, writer)
+		close(mockWriteFetcher.WriterOutput.Err)
+
+		addr, err := server.Start("127.0.0.1:0", mockWriteFetcher, mockReadFetcher)
+		Expect(t, err == nil).To(BeTrue())
+
+		return TI{
+			T:       t,
+			client:  fetchIngressClient(addr),
+			batches: batches,
+			errs:    errs,
+		}
+	})
+
+	o.Spec("it writes envelopes from Sender", func(t TI) {
+		sender, err := t.client.Sender(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&v2.Envelope{SourceId: "some-id"})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = sender.CloseAndRecv()
+		Expect(t, err == nil).To(BeTrue())
+
+		var batch [][]byte
+		Expect(t, t.batches).To(ViaPolling(
+			Chain(Receive(), Fetch(&batch)),
+		))
+		Expect(t, sourceIDs(t, batch)).To(Equal([]string{"some-id"}))
+	})
+
+	o.Spec("it writes each batch from BatchSender together", func(t TI) {
+		sender, err := t.client.BatchSender(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&v2.EnvelopeBatch{
+			Batch: []*v2.Envelope{{SourceId: "a"}, {SourceId: "b"}},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = sender.CloseAndRecv()
+		Expect(t, err == nil).To(BeTrue())
+
+		var batch [][]byte
+		Expect(t, t.batches).To(ViaPolling(
+			Chain(Receive(), Fetch(&batch)),
+		))
+		Expect(t, sourceIDs(t, batch)).To(Equal([]string{"a", "b"}))
+	})
+
+	o.Spec("it writes the batch from Send", func(t TI) {
+		_, err := t.client.Send(context.Background(), &v2.EnvelopeBatch{
+			Batch: []*v2.Envelope{{SourceId: "a"}, {SourceId: "b"}},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		var batch [][]byte
+		Expect(t, t.batches).To(ViaPolling(
+			Chain(Receive(), Fetch(&batch)),
+		))
+		Expect(t, sourceIDs(t, batch)).To(Equal([]string{"a", "b"}))
+	})
+
+	o.Spec("it returns Unavailable from Send when a write fails", func(t TI) {
+		t.errs <- nil
+		t.errs <- fmt.Errorf("some-error")
+
+		_, err := t.client.Send(context.Background(), &v2.EnvelopeBatch{
+			Batch: []*v2.Envelope{{SourceId: "a"}, {SourceId: "b"}},
+		})
+		Expect(t, status.Code(err)).To(Equal(codes.Unavailable))
+	})
+
+	o.Spec("it returns ResourceExhausted from Send when a source is over quota", func(t TI) {
+		t.errs <- &ratelimit.Error{SourceID: "a"}
+
+		_, err := t.client.Send(context.Background(), &v2.EnvelopeBatch{
+			Batch: []*v2.Envelope{{SourceId: "a"}},
+		})
+		Expect(t, status.Code(err)).To(Equal(codes.ResourceExhausted))
+	})
+
+	o.Spec("it ends the Sender stream when a write fails", func(t TI) {
+		t.errs <- fmt.Errorf("some-error")
+
+		sender, err := t.client.Sender(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&v2.Envelope{SourceId: "some-id"})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = sender.CloseAndRecv()
+		Expect(t, status.Code(err)).To(Equal(codes.Unavailable))
+	})
+
+	o.Spec("it ends the BatchSender stream when a write fails", func(t TI) {
+		t.errs <- fmt.Errorf("some-error")
+
+		sender, err := t.client.BatchSender(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&v2.EnvelopeBatch{
+			Batch: []*v2.Envelope{{SourceId: "a"}},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = sender.CloseAndRecv()
+		Expect(t, status.Code(err)).To(Equal(codes.Unavailable))
+	})
+}
+
+func sourceIDs(t TI, batch [][]byte) []string {
+	var ids []string
+	for _, data := range batch {
+		var e v2.Envelope
+		if err := proto.Unmarshal(data, &e); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, e.SourceId)
+	}
+	return ids
+}
+
+func fetchIngressClient(addr string) v2.IngressClient {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		panic(err)
+	}
+	return v2.NewIngressClient(conn)
+}
@@ -5,7 +5,12 @@
 
 package intra_test
 
-import "github.com/poy/petasos/router"
+import (
+	"github.com/poy/loggrebutterfly/datanode/internal/deadletter"
+	"github.com/poy/loggrebutterfly/datanode/internal/skew"
+	"github.com/poy/loggrebutterfly/internal/sequence"
+	"github.com/poy/petasos/router"
+)
 
 type mockMetricsReader struct {
 	MetricsCalled chan bool
@@ -29,3 +34,146 @@ func (m *mockMetricsReader) Metrics(rn router.RangeName) (metric router.Metric)
 	m.MetricsInput.Rn <- rn
 	return <-m.MetricsOutput.Metric
 }
+
+type mockSequenceStatsReader struct {
+	StatsCalled chan bool
+	StatsInput  struct {
+		SourceID chan string
+	}
+	StatsOutput struct {
+		Ret0 chan []sequence.SourceStats
+	}
+}
+
+func newMockSequenceStatsReader() *mockSequenceStatsReader {
+	m := &mockSequenceStatsReader{}
+	m.StatsCalled = make(chan bool, 100)
+	m.StatsInput.SourceID = make(chan string, 100)
+	m.StatsOutput.Ret0 = make(chan []sequence.SourceStats, 100)
+	return m
+}
+func (m *mockSequenceStatsReader) Stats(sourceID string) []sequence.SourceStats {
+	m.StatsCalled <- true
+	m.StatsInput.SourceID <- sourceID
+	return <-m.StatsOutput.Ret0
+}
+
+type mockDuplicateCounter struct {
+	DuplicatesCalled chan bool
+	DuplicatesInput  struct {
+		File chan string
+	}
+	DuplicatesOutput struct {
+		Ret0 chan uint64
+	}
+}
+
+func newMockDuplicateCounter() *mockDuplicateCounter {
+	m := &mockDuplicateCounter{}
+	m.DuplicatesCalled = make(chan bool, 100)
+	m.DuplicatesInput.File = make(chan string, 100)
+	m.DuplicatesOutput.Ret0 = make(chan uint64, 100)
+	return m
+}
+func (m *mockDuplicateCounter) Duplicates(file string) uint64 {
+	m.DuplicatesCalled <- true
+	m.DuplicatesInput.File <- file
+	return <-m.DuplicatesOutput.Ret0
+}
+
+type mockDeadLetterReader struct {
+	ReadCalled chan bool
+	ReadInput  struct {
+		StartID chan uint64
+		Limit   chan int
+	}
+	ReadOutput struct {
+		Ret0 chan []deadletter.Letter
+	}
+}
+
+func newMockDeadLetterReader() *mockDeadLetterReader {
+	m := &mockDeadLetterReader{}
+	m.ReadCalled = make(chan bool, 100)
+	m.ReadInput.StartID = make(chan uint64, 100)
+	m.ReadInput.Limit = make(chan int, 100)
+	m.ReadOutput.Ret0 = make(chan []deadletter.Letter, 100)
+	return m
+}
+func (m *mockDeadLetterReader) Read(startID uint64, limit int) []deadletter.Letter {
+	m.ReadCalled <- true
+	m.ReadInput.StartID <- startID
+	m.ReadInput.Limit <- limit
+	return <-m.ReadOutput.Ret0
+}
+
+type mockReplayer struct {
+	ReplayCalled chan bool
+	ReplayInput  struct {
+		Ids chan []uint64
+	}
+	ReplayOutput struct {
+		Replayed chan uint64
+		Failed   chan []deadletter.Letter
+	}
+}
+
+func newMockReplayer() *mockReplayer {
+	m := &mockReplayer{}
+	m.ReplayCalled = make(chan bool, 100)
+	m.ReplayInput.Ids = make(chan []uint64, 100)
+	m.ReplayOutput.Replayed = make(chan uint64, 100)
+	m.ReplayOutput.Failed = make(chan []deadletter.Letter, 100)
+	return m
+}
+func (m *mockReplayer) Replay(ids []uint64) (replayed uint64, failed []deadletter.Letter) {
+	m.ReplayCalled <- true
+	m.ReplayInput.Ids <- ids
+	return <-m.ReplayOutput.Replayed, <-m.ReplayOutput.Failed
+}
+
+type mockSkewStatsReader struct {
+	StatsCalled chan bool
+	StatsInput  struct {
+		SourceID chan string
+	}
+	StatsOutput struct {
+		Ret0 chan []skew.SourceStats
+	}
+}
+
+func newMockSkewStatsReader() *mockSkewStatsReader {
+	m := &mockSkewStatsReader{}
+	m.StatsCalled = make(chan bool, 100)
+	m.StatsInput.SourceID = make(chan string, 100)
+	m.StatsOutput.Ret0 = make(chan []skew.SourceStats, 100)
+	return m
+}
+func (m *mockSkewStatsReader) Stats(sourceID string) []skew.SourceStats {
+	m.StatsCalled <- true
+	m.StatsInput.SourceID <- sourceID
+	return <-m.StatsOutput.Ret0
+}
+
+type mockThrottleCounter struct {
+	ThrottledCalled chan bool
+	ThrottledInput  struct {
+		Rn chan router.RangeName
+	}
+	ThrottledOutput struct {
+		Ret0 chan uint64
+	}
+}
+
+func newMockThrottleCounter() *mockThrottleCounter {
+	m := &mockThrottleCounter{}
+	m.ThrottledCalled = make(chan bool, 100)
+	m.ThrottledInput.Rn = make(chan router.RangeName, 100)
+	m.ThrottledOutput.Ret0 = make(chan uint64, 100)
+	return m
+}
+func (m *mockThrottleCounter) Throttled(rn router.RangeName) uint64 {
+	m.ThrottledCalled <- true
+	m.ThrottledInput.Rn <- rn
+	return <-m.ThrottledOutput.Ret0
+}
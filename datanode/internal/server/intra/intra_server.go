@@ -8,6 +8,9 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/poy/loggrebutterfly/api/intra"
+	"github.com/poy/loggrebutterfly/datanode/internal/deadletter"
+	"github.com/poy/loggrebutterfly/datanode/internal/skew"
+	"github.com/poy/loggrebutterfly/internal/sequence"
 	"github.com/poy/petasos/router"
 	"google.golang.org/grpc"
 )
@@ -16,12 +19,59 @@ type MetricsReader interface {
 	Metrics(rn router.RangeName) (metric router.Metric)
 }
 
+type SequenceStatsReader interface {
+	Stats(sourceID string) []sequence.SourceStats
+}
+
+type DuplicateCounter interface {
+	Duplicates(file string) uint64
+}
+
+type ThrottleCounter interface {
+	Throttled(rn router.RangeName) uint64
+}
+
+type DeadLetterReader interface {
+	Read(startID uint64, limit int) []deadletter.Letter
+}
+
+type Replayer interface {
+	Replay(ids []uint64) (replayed uint64, failed []deadletter.Letter)
+}
+
+type SkewStatsReader interface {
+	Stats(sourceID string) []skew.SourceStats
+}
+
 type IntraServer struct {
-	metricsReader MetricsReader
+	metricsReader    MetricsReader
+	statsReader      SequenceStatsReader
+	duplicateCounter DuplicateCounter
+	throttleCounter  ThrottleCounter
+	deadLetterReader DeadLetterReader
+	replayer         Replayer
+	skewReader       SkewStatsReader
 }
 
-func Start(addr string, metricsReader MetricsReader) (actualAddr string, err error) {
-	is := &IntraServer{metricsReader: metricsReader}
+func Start(
+	addr string,
+	metricsReader MetricsReader,
+	statsReader SequenceStatsReader,
+	duplicateCounter DuplicateCounter,
+	throttleCounter ThrottleCounter,
+	deadLetterReader DeadLetterReader,
+	replayer Replayer,
+	skewReader SkewStatsReader,
+) (actualAddr string, err error) {
+	is := &IntraServer{
+		metricsReader:    metricsReader,
+		statsReader:      statsReader,
+		duplicateCounter: duplicateCounter,
+		throttleCounter:  throttleCounter,
+		deadLetterReader: deadLetterReader,
+		replayer:         replayer,
+		skewReader:       skewReader,
+	}
 
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -48,7 +98,69 @@ func (s *IntraServer) ReadMetrics(ctx context.Context, in *intra.ReadMetricsInfo
 
 	metrics := s.metricsReader.Metrics(rn)
 	return &intra.ReadMetricsResponse{
-		WriteCount: metrics.WriteCount,
-		ErrCount:   metrics.ErrCount,
+		WriteCount:     metrics.WriteCount,
+		ErrCount:       metrics.ErrCount,
+		DuplicateCount: s.duplicateCounter.Duplicates(in.File),
+		ThrottledCount: s.throttleCounter.Throttled(rn),
+	}, nil
+}
+
+func (s *IntraServer) ReadSequenceStats(ctx context.Context, in *intra.ReadSequenceStatsInfo) (*intra.ReadSequenceStatsResponse, error) {
+	var resp intra.ReadSequenceStatsResponse
+	for _, stats := range s.statsReader.Stats(in.SourceId) {
+		resp.Stats = append(resp.Stats, &intra.SequenceStats{
+			SourceId:     stats.SourceID,
+			Epoch:        stats.Epoch,
+			LastSequence: stats.LastSequence,
+			Gaps:         stats.Gaps,
+			Reorders:     stats.Reorders,
+			Stream:       stats.Stream,
+		})
+	}
+
+	return &resp, nil
+}
+
+func (s *IntraServer) ReadDeadLetters(ctx context.Context, in *intra.ReadDeadLettersInfo) (*intra.ReadDeadLettersResponse, error) {
+	letters := s.deadLetterReader.Read(in.StartId, int(in.Limit))
+	return &intra.ReadDeadLettersResponse{
+		Letters: convertLetters(letters),
+	}, nil
+}
+
+func (s *IntraServer) ReplayDeadLetters(ctx context.Context, in *intra.ReplayDeadLettersInfo) (*intra.ReplayDeadLettersResponse, error) {
+	replayed, failed := s.replayer.Replay(in.Ids)
+	return &intra.ReplayDeadLettersResponse{
+		Replayed: replayed,
+		Failed:   convertLetters(failed),
 	}, nil
 }
+
+func (s *IntraServer) ReadSkewStats(ctx context.Context, in *intra.ReadSkewStatsInfo) (*intra.ReadSkewStatsResponse, error) {
+	var resp intra.ReadSkewStatsResponse
+	for _, stats := range s.skewReader.Stats(in.SourceId) {
+		resp.Stats = append(resp.Stats, &intra.SkewStats{
+			SourceId:  stats.SourceID,
+			Count:     stats.Count,
+			Skewed:    stats.Skewed,
+			MaxAhead:  int64(stats.MaxAhead),
+			MaxBehind: int64(stats.MaxBehind),
+			Last:      int64(stats.Last),
+		})
+	}
+
+	return &resp, nil
+}
+
+func convertLetters(letters []deadletter.Letter) []*intra.DeadLetter {
+	var result []*intra.DeadLetter
+	for _, l := range letters {
+		result = append(result, &intra.DeadLetter{
+			Id:        l.ID,
+			Payload:   l.Payload,
+			Reason:    l.Reason,
+			Timestamp: l.Timestamp,
+		})
+	}
+	return result
+}
@@ -14,6 +14,9 @@ import (
 
 	"github.com/poy/loggrebutterfly/datanode/internal/server/intra"
 	pb "github.com/poy/loggrebutterfly/api/intra"
+	"github.com/poy/loggrebutterfly/datanode/internal/deadletter"
+	"github.com/poy/loggrebutterfly/datanode/internal/skew"
+	"github.com/poy/loggrebutterfly/internal/sequence"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -31,8 +34,14 @@ func TestMain(m *testing.M) {
 
 type TI struct {
 	*testing.T
-	client            pb.DataNodeClient
-	mockMetricsReader *mockMetricsReader
+	client                  pb.DataNodeClient
+	mockMetricsReader       *mockMetricsReader
+	mockSequenceStatsReader *mockSequenceStatsReader
+	mockDuplicateCounter    *mockDuplicateCounter
+	mockThrottleCounter     *mockThrottleCounter
+	mockDeadLetterReader    *mockDeadLetterReader
+	mockReplayer            *mockReplayer
+	mockSkewStatsReader     *mockSkewStatsReader
 }
 
 func TestIntra(t *testing.T) {
@@ -42,14 +51,35 @@ func TestIntra(t *testing.T) {
 
 	o.BeforeEach(func(t *testing.T) TI {
 		mockMetricsReader := newMockMetricsReader()
+		mockSequenceStatsReader := newMockSequenceStatsReader()
+		mockDuplicateCounter := newMockDuplicateCounter()
+		mockThrottleCounter := newMockThrottleCounter()
+		mockDeadLetterReader := newMockDeadLetterReader()
+		mockReplayer := newMockReplayer()
+		mockSkewStatsReader := newMockSkewStatsReader()
 
-		addr, err := intra.Start("127.0.0.1:0", mockMetricsReader)
+		addr, err := intra.Start(
+			"127.0.0.1:0",
+			mockMetricsReader,
+			mockSequenceStatsReader,
+			mockDuplicateCounter,
+			mockThrottleCounter,
+			mockDeadLetterReader,
+			mockReplayer,
+			mockSkewStatsReader,
+		)
 		Expect(t, err == nil).To(BeTrue())
 
 		return TI{
-			T:                 t,
-			client:            fetchClient(addr),
-			mockMetricsReader: mockMetricsReader,
+			T:                       t,
+			client:                  fetchClient(addr),
+			mockMetricsReader:       mockMetricsReader,
+			mockSequenceStatsReader: mockSequenceStatsReader,
+			mockDuplicateCounter:    mockDuplicateCounter,
+			mockThrottleCounter:     mockThrottleCounter,
+			mockDeadLetterReader:    mockDeadLetterReader,
+			mockReplayer:            mockReplayer,
+			mockSkewStatsReader:     mockSkewStatsReader,
 		}
 	})
 
@@ -58,6 +88,8 @@ func TestIntra(t *testing.T) {
 			WriteCount: 99,
 			ErrCount:   101,
 		}
+		t.mockDuplicateCounter.DuplicatesOutput.Ret0 <- 103
+		t.mockThrottleCounter.ThrottledOutput.Ret0 <- 105
 
 		resp, err := t.client.ReadMetrics(context.Background(), &pb.ReadMetricsInfo{
 			File: `{"Term":99}`,
@@ -65,6 +97,90 @@ func TestIntra(t *testing.T) {
 		Expect(t, err == nil).To(BeTrue())
 		Expect(t, resp.WriteCount).To(Equal(uint64(99)))
 		Expect(t, resp.ErrCount).To(Equal(uint64(101)))
+		Expect(t, resp.DuplicateCount).To(Equal(uint64(103)))
+		Expect(t, resp.ThrottledCount).To(Equal(uint64(105)))
+		Expect(t, t.mockThrottleCounter.ThrottledInput.Rn).To(
+			Chain(Receive(), Equal(router.RangeName{Term: 99})),
+		)
+		Expect(t, t.mockDuplicateCounter.DuplicatesInput.File).To(
+			Chain(Receive(), Equal(`{"Term":99}`)),
+		)
+	})
+
+	o.Spec("reports the sequence stats from the stats reader", func(t TI) {
+		t.mockSequenceStatsReader.StatsOutput.Ret0 <- []sequence.SourceStats{
+			{SourceID: "some-id", Stream: "some-stream", Epoch: 1, LastSequence: 99, Gaps: 2, Reorders: 3},
+		}
+
+		resp, err := t.client.ReadSequenceStats(context.Background(), &pb.ReadSequenceStatsInfo{
+			SourceId: "some-id",
+		})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, t.mockSequenceStatsReader.StatsInput.SourceID).To(
+			Chain(Receive(), Equal("some-id")),
+		)
+		Expect(t, resp.Stats).To(Equal([]*pb.SequenceStats{
+			{SourceId: "some-id", Stream: "some-stream", Epoch: 1, LastSequence: 99, Gaps: 2, Reorders: 3},
+		}))
+	})
+
+	o.Spec("reports the dead letters from the reader", func(t TI) {
+		t.mockDeadLetterReader.ReadOutput.Ret0 <- []deadletter.Letter{
+			{ID: 7, Payload: []byte("some-payload"), Reason: "some-reason", Timestamp: 99},
+		}
+
+		resp, err := t.client.ReadDeadLetters(context.Background(), &pb.ReadDeadLettersInfo{
+			StartId: 5,
+			Limit:   10,
+		})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, t.mockDeadLetterReader.ReadInput.StartID).To(
+			Chain(Receive(), Equal(uint64(5))),
+		)
+		Expect(t, t.mockDeadLetterReader.ReadInput.Limit).To(
+			Chain(Receive(), Equal(10)),
+		)
+		Expect(t, resp.Letters).To(HaveLen(1))
+		Expect(t, resp.Letters[0].Id).To(Equal(uint64(7)))
+		Expect(t, resp.Letters[0].Payload).To(Equal([]byte("some-payload")))
+		Expect(t, resp.Letters[0].Reason).To(Equal("some-reason"))
+		Expect(t, resp.Letters[0].Timestamp).To(Equal(int64(99)))
+	})
+
+	o.Spec("replays the requested dead letters", func(t TI) {
+		t.mockReplayer.ReplayOutput.Replayed <- 1
+		t.mockReplayer.ReplayOutput.Failed <- []deadletter.Letter{
+			{ID: 8, Reason: "some-reason"},
+		}
+
+		resp, err := t.client.ReplayDeadLetters(context.Background(), &pb.ReplayDeadLettersInfo{
+			Ids: []uint64{7, 8},
+		})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, t.mockReplayer.ReplayInput.Ids).To(
+			Chain(Receive(), Equal([]uint64{7, 8})),
+		)
+		Expect(t, resp.Replayed).To(Equal(uint64(1)))
+		Expect(t, resp.Failed).To(HaveLen(1))
+		Expect(t, resp.Failed[0].Id).To(Equal(uint64(8)))
+		Expect(t, resp.Failed[0].Reason).To(Equal("some-reason"))
+	})
+
+	o.Spec("reports the skew stats from the skew reader", func(t TI) {
+		t.mockSkewStatsReader.StatsOutput.Ret0 <- []skew.SourceStats{
+			{SourceID: "some-id", Count: 5, Skewed: 2, MaxAhead: 99, MaxBehind: 101, Last: -3},
+		}
+
+		resp, err := t.client.ReadSkewStats(context.Background(), &pb.ReadSkewStatsInfo{
+			SourceId: "some-id",
+		})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, t.mockSkewStatsReader.StatsInput.SourceID).To(
+			Chain(Receive(), Equal("some-id")),
+		)
+		Expect(t, resp.Stats).To(Equal([]*pb.SkewStats{
+			{SourceId: "some-id", Count: 5, Skewed: 2, MaxAhead: 99, MaxBehind: 101, Last: -3},
+		}))
 	})
 }
 
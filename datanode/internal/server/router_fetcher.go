@@ -1,21 +1,224 @@
 package server
 
-import "github.com/poy/petasos/router"
+import (
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/deadletter"
+	"github.com/poy/loggrebutterfly/datanode/internal/dedup"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
+	"github.com/poy/petasos/router"
+)
+
+type Hasher interface {
+	router.Hasher
+	HashEnvelope(e *v2.Envelope) (hash uint64)
+}
+
+type Validator interface {
+	Validate(data []byte) (*v2.Envelope, error)
+}
+
+type Transformer interface {
+	Transform(e *v2.Envelope) (transformed *v2.Envelope, keep bool)
+}
+
+type Normalizer interface {
+	Normalize(e *v2.Envelope) (*v2.Envelope, error)
+}
+
+type Clock interface {
+	Stamp(e *v2.Envelope) *v2.Envelope
+}
+
+type Limiter interface {
+	Allow(sourceID string, rn router.RangeName) error
+}
+
+type DeadLetters interface {
+	Add(payload []byte, reason string)
+	Take(ids []uint64) []deadletter.Letter
+	Return(letters []deadletter.Letter)
+}
 
 type RouterFetcher struct {
 	fs             router.FileSystem
-	hasher         router.Hasher
+	routes         *routes
+	hasher         Hasher
 	metricsCounter router.MetricsCounter
+	validator      Validator
+	transformer    Transformer
+	normalizer     Normalizer
+	clock          Clock
+	limiter        Limiter
+	deadLetters    DeadLetters
 }
 
 func NewRouterFetcher(
 	fs router.FileSystem,
-	hasher router.Hasher,
+	hasher Hasher,
 	metricsCounter router.MetricsCounter,
+	validator Validator,
+	transformer Transformer,
+	normalizer Normalizer,
+	clock Clock,
+	limiter Limiter,
+	deadLetters DeadLetters,
 ) *RouterFetcher {
-	return &RouterFetcher{fs: fs, hasher: hasher, metricsCounter: metricsCounter}
+	return &RouterFetcher{
+		fs:             fs,
+		routes:         newRoutes(fs, routesTTL),
+		hasher:         hasher,
+		metricsCounter: metricsCounter,
+		validator:      validator,
+		transformer:    transformer,
+		normalizer:     normalizer,
+		clock:          clock,
+		limiter:        limiter,
+		deadLetters:    deadLetters,
+	}
+}
+
+// Writer returns a function that writes a batch of envelopes. The batch is
+// grouped by range and each group is written to talaria in one call. Each
+// envelope is passed through the ingest pipeline and stamped with the
+// ingest timestamp and a hybrid logical clock value. Envelopes dropped by
+// the pipeline are acknowledged without being written. Envelopes from
+// sources that are over their rate limit are rejected. Payloads that fail
+// validation are kept as dead letters.
+func (f *RouterFetcher) Writer() (writer func(batch [][]byte) (errs []error), err error) {
+	return func(batch [][]byte) []error {
+		errs := f.write(batch)
+		for i, err := range errs {
+			if _, ok := err.(*validation.Error); ok {
+				f.deadLetters.Add(batch[i], err.Error())
+			}
+		}
+
+		return errs
+	}, nil
+}
+
+// Replay writes the dead letters with the given IDs again. Letters that
+// fail are returned to the dead letters with the new reason.
+func (f *RouterFetcher) Replay(ids []uint64) (replayed uint64, failed []deadletter.Letter) {
+	letters := f.deadLetters.Take(ids)
+	if len(letters) == 0 {
+		return 0, nil
+	}
+
+	batch := make([][]byte, 0, len(letters))
+	for _, l := range letters {
+		batch = append(batch, l.Payload)
+	}
+
+	for i, err := range f.write(batch) {
+		if err == nil {
+			replayed++
+			continue
+		}
+
+		letters[i].Reason = err.Error()
+		failed = append(failed, letters[i])
+	}
+	f.deadLetters.Return(failed)
+
+	return replayed, failed
+}
+
+func (f *RouterFetcher) write(batch [][]byte) []error {
+	errs := make([]error, len(batch))
+	payloads := make([][]byte, len(batch))
+	groups := make(map[route][]int)
+	var order []route
+	for i, data := range batch {
+		e, keep, err := f.prepare(data)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		if !keep {
+			continue
+		}
+
+		payloads[i], err = proto.Marshal(e)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		rt, err := f.routes.lookup(f.hasher.HashEnvelope(e))
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		if errs[i] = f.limiter.Allow(e.SourceId, rt.rangeName); errs[i] != nil {
+			continue
+		}
+
+		if _, ok := groups[rt]; !ok {
+			order = append(order, rt)
+		}
+		groups[rt] = append(groups[rt], i)
+	}
+
+	for _, rt := range order {
+		group := groups[rt]
+		data := make([][]byte, 0, len(group))
+		for _, i := range group {
+			data = append(data, payloads[i])
+		}
+
+		err := f.writeGroup(rt.file, data)
+		if err != nil {
+			f.routes.reset()
+		}
+
+		for _, i := range group {
+			errs[i] = err
+			f.metricsCounter.Inc(rt.rangeName, err == nil)
+		}
+	}
+
+	return errs
+}
+
+func (f *RouterFetcher) writeGroup(file string, batch [][]byte) error {
+	w, err := f.fs.Writer(file)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	if bw, ok := w.(dedup.BatchWriter); ok {
+		return bw.WriteBatch(batch)
+	}
+
+	for _, data := range batch {
+		if err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (f *RouterFetcher) Writer() (writer func(data []byte) (err error), err error) {
-	return router.New(f.fs, f.hasher, f.metricsCounter).Write, nil
+// prepare validates and transforms the payload and stamps the envelope.
+// keep is false if the envelope was dropped by the pipeline.
+func (f *RouterFetcher) prepare(data []byte) (e *v2.Envelope, keep bool, err error) {
+	e, err = f.validator.Validate(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	e, keep = f.transformer.Transform(e)
+	if !keep {
+		return nil, false, nil
+	}
+
+	e, err = f.normalizer.Normalize(e)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return f.clock.Stamp(e), true, nil
 }
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/poy/loggrebutterfly/internal/hashing"
+	"github.com/poy/petasos/router"
+)
+
+type route struct {
+	file      string
+	rangeName router.RangeName
+}
+
+// routesTTL is how long the listed ranges are used. Ranges that the
+// balancer splits or gives a new term are picked up once it passes.
+const routesTTL = 5 * time.Second
+
+// routes finds the range for a hash. The ranges are listed again after
+// their TTL, when a hash does not fit any of them or when a write fails.
+type routes struct {
+	fs  router.FileSystem
+	ttl time.Duration
+
+	mu     sync.Mutex
+	ranges []route
+	listed time.Time
+}
+
+func newRoutes(fs router.FileSystem, ttl time.Duration) *routes {
+	return &routes{
+		fs:  fs,
+		ttl: ttl,
+	}
+}
+
+func (r *routes) lookup(hash uint64) (route, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Stale ranges are still used if they can not be listed again.
+	listed := false
+	if time.Since(r.listed) > r.ttl {
+		listed = r.list() == nil
+	}
+
+	if rt, ok := r.find(hash); ok {
+		return rt, nil
+	}
+
+	if !listed {
+		if err := r.list(); err != nil {
+			return route{}, err
+		}
+	}
+
+	if rt, ok := r.find(hash); ok {
+		return rt, nil
+	}
+	return route{}, fmt.Errorf("no range for hash %d", hash)
+}
+
+// list lists the ranges. It must be called with the lock held.
+func (r *routes) list() error {
+	files, err := r.fs.List()
+	if err != nil {
+		return err
+	}
+
+	r.ranges = r.ranges[:0]
+	for _, file := range files {
+		rn, err := hashing.ParseRangeName(file)
+		if err != nil {
+			continue
+		}
+		r.ranges = append(r.ranges, route{file: file, rangeName: rn.RangeName})
+	}
+	r.listed = time.Now()
+
+	return nil
+}
+
+func (r *routes) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ranges = nil
+}
+
+// find returns the range with the latest term that holds the hash.
+func (r *routes) find(hash uint64) (route, bool) {
+	var (
+		found route
+		ok    bool
+	)
+	for _, rt := range r.ranges {
+		if hash < rt.rangeName.Low || hash > rt.rangeName.High {
+			continue
+		}
+
+		if !ok || rt.rangeName.Term > found.rangeName.Term {
+			found, ok = rt, true
+		}
+	}
+	return found, ok
+}
@@ -1,16 +1,22 @@
 package server
 
 import (
+	"io"
 	"log"
 	"net"
 
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
 	pb "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
+	"github.com/poy/loggrebutterfly/internal/envfilter"
 
 	"google.golang.org/grpc"
 )
 
 type WriteFetcher interface {
-	Writer() (writer func(data []byte) (err error), err error)
+	Writer() (writer func(batch [][]byte) (errs []error), err error)
 }
 
 type ReadFetcher interface {
@@ -18,15 +24,29 @@ type ReadFetcher interface {
 }
 
 type Server struct {
-	writer WriteFetcher
-	reader ReadFetcher
+	writer       WriteFetcher
+	reader       ReadFetcher
+	deduplicated bool
 }
 
-func Start(addr string, writer WriteFetcher, reader ReadFetcher) (actualAddr string, err error) {
+type Option func(*Server)
+
+// WithDeduplication tells the writers that duplicate envelopes are dropped,
+// so they may write unacknowledged envelopes again.
+func WithDeduplication() Option {
+	return func(s *Server) {
+		s.deduplicated = true
+	}
+}
+
+func Start(addr string, writer WriteFetcher, reader ReadFetcher, opts ...Option) (actualAddr string, err error) {
 	s := &Server{
 		writer: writer,
 		reader: reader,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -34,6 +54,7 @@ func Start(addr string, writer WriteFetcher, reader ReadFetcher) (actualAddr str
 	}
 	g := grpc.NewServer()
 	pb.RegisterDataNodeServer(g, s)
+	v2.RegisterIngressServer(g, &ingressServer{writer: writer})
 
 	go func() {
 		if err := g.Serve(lis); err != nil {
@@ -44,25 +65,90 @@ func Start(addr string, writer WriteFetcher, reader ReadFetcher) (actualAddr str
 	return lis.Addr().String(), nil
 }
 
+// Write writes each batch of envelopes and responds with a cumulative ack.
+// Envelopes that could not be written are reported as rejections instead
+// of closing the stream.
 func (s *Server) Write(sender pb.DataNode_WriteServer) error {
 	w, err := s.writer.Writer()
 	if err != nil {
 		return err
 	}
 
+	var position uint64
 	for {
 		info, err := sender.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
 		if err != nil {
 			return err
 		}
 
-		if err := w(info.Payload); err != nil {
+		batch := info.Payloads
+		if len(info.Payload) > 0 {
+			batch = append([][]byte{info.Payload}, batch...)
+		}
+
+		if len(batch) == 0 {
+			continue
+		}
+
+		seq := info.Sequence
+		if seq == 0 {
+			seq = position + 1
+		}
+		position += uint64(len(batch))
+
+		resp := &pb.WriteResponse{
+			Ack:          seq + uint64(len(batch)) - 1,
+			Deduplicated: s.deduplicated,
+		}
+		for i, err := range w(batch) {
+			if err == nil {
+				continue
+			}
+
+			log.Printf("Rejecting envelope %d: %s", seq+uint64(i), err)
+			resp.Rejections = append(resp.Rejections, rejection(seq+uint64(i), err))
+		}
+
+		if err := sender.Send(resp); err != nil {
 			return err
 		}
 	}
 }
 
+func rejection(seq uint64, err error) *pb.Rejection {
+	r := &pb.Rejection{
+		Sequence: seq,
+		Reason:   err.Error(),
+	}
+
+	switch err := err.(type) {
+	case *validation.Error:
+		r.Code = pb.RejectionCode_INVALID
+	case *ratelimit.Error:
+		r.Code = pb.RejectionCode_OVER_QUOTA
+		r.SourceId = err.SourceID
+		r.RetryAfterNs = int64(err.RetryAfter)
+	}
+
+	return r
+}
+
+// Read streams the range from the given index. If the request has a
+// filter, only the envelopes that match it are sent.
 func (s *Server) Read(info *pb.ReadInfo, server pb.DataNode_ReadServer) error {
+	var filter *envfilter.Filter
+	if info.Filter != nil {
+		var err error
+		filter, err = envfilter.New(info.Filter, envfilter.MatchAnySource())
+		if err != nil {
+			return err
+		}
+	}
+
 	reader, err := s.reader.Reader(info.Name, info.Index)
 	if err != nil {
 		return err
@@ -74,8 +160,21 @@ func (s *Server) Read(info *pb.ReadInfo, server pb.DataNode_ReadServer) error {
 			return err
 		}
 
+		if filter != nil && !keep(filter, data.Payload) {
+			continue
+		}
+
 		if err := server.Send(data); err != nil {
 			return err
 		}
 	}
 }
+
+func keep(filter *envfilter.Filter, payload []byte) bool {
+	var e v2.Envelope
+	if err := proto.Unmarshal(payload, &e); err != nil {
+		return false
+	}
+
+	return filter.Keep(&e)
+}
@@ -5,17 +5,23 @@ package server_test
 import (
 	"context"
 	"flag"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/poy/eachers/testhelpers"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
 	pb "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
 	"github.com/poy/loggrebutterfly/datanode/internal/server"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
 
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
@@ -52,9 +58,13 @@ func TestServer(t *testing.T) {
 
 		data := make(chan []byte, 100)
 		errs := make(chan error, 100)
-		writer := func(d []byte) error {
-			data <- d
-			return <-errs
+		writer := func(batch [][]byte) []error {
+			var result []error
+			for _, d := range batch {
+				data <- d
+				result = append(result, <-errs)
+			}
+			return result
 		}
 		testhelpers.AlwaysReturn(mockWriteFetcher.WriterOutput.Writer, writer)
 
@@ -89,6 +99,121 @@ func TestServer(t *testing.T) {
 		))
 	})
 
+	o.Spec("it acks each written envelope", func(t TS) {
+		t.errs <- nil
+		t.errs <- nil
+		sender, err := t.client.Write(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&pb.WriteInfo{Payload: []byte("some-data")})
+		Expect(t, err == nil).To(BeTrue())
+		err = sender.Send(&pb.WriteInfo{Payload: []byte("some-data"), Sequence: 99})
+		Expect(t, err == nil).To(BeTrue())
+
+		resp, err := sender.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp).To(Equal(&pb.WriteResponse{Ack: 1}))
+
+		resp, err = sender.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp).To(Equal(&pb.WriteResponse{Ack: 99}))
+	})
+
+	o.Spec("it tells the writer that duplicates are dropped", func(t TS) {
+		addr, err := server.Start("127.0.0.1:0", t.mockWriteFetcher, t.mockReadFetcher, server.WithDeduplication())
+		Expect(t, err == nil).To(BeTrue())
+
+		t.errs <- nil
+		sender, err := fetchClient(addr).Write(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&pb.WriteInfo{Payload: []byte("some-data")})
+		Expect(t, err == nil).To(BeTrue())
+
+		resp, err := sender.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp).To(Equal(&pb.WriteResponse{Ack: 1, Deduplicated: true}))
+	})
+
+	o.Spec("it writes batches of payloads", func(t TS) {
+		t.errs <- nil
+		t.errs <- fmt.Errorf("some-error")
+		t.errs <- nil
+		sender, err := t.client.Write(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&pb.WriteInfo{
+			Payload:  []byte("some-data-a"),
+			Payloads: [][]byte{[]byte("some-data-b"), []byte("some-data-c")},
+			Sequence: 10,
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, t.data).To(ViaPolling(
+			Chain(Receive(), Equal([]byte("some-data-a"))),
+		))
+		Expect(t, t.data).To(ViaPolling(
+			Chain(Receive(), Equal([]byte("some-data-b"))),
+		))
+		Expect(t, t.data).To(ViaPolling(
+			Chain(Receive(), Equal([]byte("some-data-c"))),
+		))
+
+		resp, err := sender.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp).To(Equal(&pb.WriteResponse{
+			Ack: 12,
+			Rejections: []*pb.Rejection{
+				{Sequence: 11, Reason: "some-error"},
+			},
+		}))
+	})
+
+	o.Spec("it reports envelopes that could not be written", func(t TS) {
+		t.errs <- fmt.Errorf("some-error")
+		t.errs <- nil
+		sender, err := t.client.Write(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&pb.WriteInfo{Payload: []byte("some-data")})
+		Expect(t, err == nil).To(BeTrue())
+		err = sender.Send(&pb.WriteInfo{Payload: []byte("some-data")})
+		Expect(t, err == nil).To(BeTrue())
+
+		resp, err := sender.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp).To(Equal(&pb.WriteResponse{
+			Ack: 1,
+			Rejections: []*pb.Rejection{
+				{Sequence: 1, Reason: "some-error"},
+			},
+		}))
+
+		resp, err = sender.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp).To(Equal(&pb.WriteResponse{Ack: 2}))
+	})
+
+	o.Spec("it reports the reason an envelope was rejected", func(t TS) {
+		t.errs <- &validation.Error{Reason: "some-reason"}
+		t.errs <- &ratelimit.Error{SourceID: "some-id", RetryAfter: time.Second}
+		sender, err := t.client.Write(context.Background())
+		Expect(t, err == nil).To(BeTrue())
+
+		err = sender.Send(&pb.WriteInfo{
+			Payloads: [][]byte{[]byte("some-data-a"), []byte("some-data-b")},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		resp, err := sender.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, resp.Rejections).To(HaveLen(2))
+		Expect(t, resp.Rejections[0].Code).To(Equal(pb.RejectionCode_INVALID))
+		Expect(t, resp.Rejections[1].Code).To(Equal(pb.RejectionCode_OVER_QUOTA))
+		Expect(t, resp.Rejections[1].SourceId).To(Equal("some-id"))
+		Expect(t, resp.Rejections[1].RetryAfterNs).To(Equal(int64(time.Second)))
+	})
+
 	o.Spec("it reads from the reader", func(t TS) {
 		t.mockReadFetcher.ReaderOutput.Reader <- buildDataF("A", "B", "C")
 
@@ -133,6 +258,52 @@ func TestServer(t *testing.T) {
 		_, err = resp.Recv()
 		Expect(t, err == nil).To(BeFalse())
 	})
+
+	o.Spec("it only sends envelopes that match the filter", func(t TS) {
+		t.mockReadFetcher.ReaderOutput.Reader <- buildDataF(
+			marshal(&v2.Envelope{SourceId: "other-id"}),
+			marshal(&v2.Envelope{SourceId: "some-id"}),
+			"invalid",
+		)
+
+		resp, err := t.client.Read(context.Background(), &pb.ReadInfo{
+			Name:   "some-name",
+			Filter: &pb.AnalystFilter{SourceId: "some-id"},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		data, err := resp.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, data.Index).To(Equal(uint64(1)))
+
+		_, err = resp.Recv()
+		Expect(t, err == nil).To(BeFalse())
+	})
+
+	o.Spec("it returns an error for an invalid filter", func(t TS) {
+		resp, err := t.client.Read(context.Background(), &pb.ReadInfo{
+			Name: "some-name",
+			Filter: &pb.AnalystFilter{
+				Envelopes: &pb.AnalystFilter_Log{
+					Log: &pb.LogFilter{
+						Payload: &pb.LogFilter_Regexp{Regexp: "["},
+					},
+				},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = resp.Recv()
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func marshal(e *v2.Envelope) string {
+	data, err := proto.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
 }
 
 func fetchClient(addr string) pb.DataNodeClient {
@@ -0,0 +1,211 @@
+package skew
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+)
+
+// Policy decides what happens to an envelope whose timestamp is too far
+// from the ingest timestamp.
+type Policy int
+
+const (
+	// Accept keeps the producer's timestamp.
+	Accept Policy = iota
+
+	// Clamp replaces the producer's timestamp with the ingest timestamp.
+	// The producer's timestamp is kept in the producer_timestamp tag.
+	Clamp
+
+	// Reject refuses the envelope.
+	Reject
+
+	// Tag keeps the producer's timestamp and records the skew in the
+	// clock_skew tag.
+	Tag
+)
+
+var policies = map[string]Policy{
+	"accept": Accept,
+	"clamp":  Clamp,
+	"reject": Reject,
+	"tag":    Tag,
+}
+
+func ParsePolicy(s string) (Policy, error) {
+	p, ok := policies[s]
+	if !ok {
+		return 0, fmt.Errorf("unknown skew policy: %s", s)
+	}
+	return p, nil
+}
+
+// SourceStats are the skew statistics for a source. Skew is the producer's
+// timestamp minus the ingest timestamp, so future-dated envelopes have a
+// positive skew.
+type SourceStats struct {
+	SourceID  string
+	Count     uint64
+	Skewed    uint64
+	MaxAhead  time.Duration
+	MaxBehind time.Duration
+	Last      time.Duration
+}
+
+// idleTimeout is how long a source can go without envelopes before its
+// stats are dropped.
+const idleTimeout = time.Hour
+
+type sourceStats struct {
+	SourceStats
+	lastSeen time.Time
+}
+
+// Normalizer stamps envelopes with the ingest timestamp and applies the
+// skew policy to envelopes outside of the allowed skew.
+type Normalizer struct {
+	policy    Policy
+	maxFuture time.Duration
+	maxPast   time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	stats     map[string]*sourceStats
+	lastSweep time.Time
+	evicted   uint64
+}
+
+// NewNormalizer returns a Normalizer. An envelope is skewed if it is more
+// than maxFuture ahead or more than maxPast behind the ingest timestamp. A
+// maxPast of 0 allows any timestamp in the past.
+func NewNormalizer(policy Policy, maxFuture, maxPast time.Duration, now func() time.Time) *Normalizer {
+	return &Normalizer{
+		policy:    policy,
+		maxFuture: maxFuture,
+		maxPast:   maxPast,
+		now:       now,
+		stats:     make(map[string]*sourceStats),
+		lastSweep: now(),
+	}
+}
+
+// Normalize returns a copy of the envelope with the ingest timestamp. A
+// *validation.Error is returned for skewed envelopes when the policy is
+// Reject.
+func (n *Normalizer) Normalize(e *v2.Envelope) (*v2.Envelope, error) {
+	ingestTime := n.now().UnixNano()
+	skew := time.Duration(e.Timestamp - ingestTime)
+	skewed := skew > n.maxFuture || (n.maxPast > 0 && -skew > n.maxPast)
+	n.observe(e.SourceId, skew, skewed, time.Unix(0, ingestTime))
+
+	if skewed && n.policy == Reject {
+		return nil, &validation.Error{
+			Reason: fmt.Sprintf("timestamp %d is skewed by %s", e.Timestamp, skew),
+		}
+	}
+
+	normalized := *e
+	normalized.Tags = make(map[string]*v2.Value, len(e.Tags)+2)
+	for k, v := range e.Tags {
+		normalized.Tags[k] = v
+	}
+	normalized.Tags[ingest.TimestampTag] = integer(ingestTime)
+
+	if skewed {
+		switch n.policy {
+		case Clamp:
+			normalized.Tags[ingest.ProducerTimestampTag] = integer(e.Timestamp)
+			normalized.Timestamp = ingestTime
+		case Tag:
+			normalized.Tags[ingest.SkewTag] = integer(int64(skew))
+		}
+	}
+
+	return &normalized, nil
+}
+
+// Stats returns the skew statistics for the given source, or for every
+// source if sourceID is empty. Sources that have been idle for an hour are
+// dropped.
+func (n *Normalizer) Stats(sourceID string) []SourceStats {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var result []SourceStats
+	for id, s := range n.stats {
+		if sourceID != "" && sourceID != id {
+			continue
+		}
+		result = append(result, s.SourceStats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SourceID < result[j].SourceID
+	})
+
+	return result
+}
+
+// TotalSkewed returns the number of skewed envelopes across every source.
+func (n *Normalizer) TotalSkewed() uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	total := n.evicted
+	for _, s := range n.stats {
+		total += s.Skewed
+	}
+	return total
+}
+
+func (n *Normalizer) observe(sourceID string, skew time.Duration, skewed bool, now time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if now.Sub(n.lastSweep) > idleTimeout {
+		n.sweep(now)
+	}
+
+	s, ok := n.stats[sourceID]
+	if !ok {
+		s = &sourceStats{SourceStats: SourceStats{SourceID: sourceID}}
+		n.stats[sourceID] = s
+	}
+
+	s.lastSeen = now
+	s.Count++
+	s.Last = skew
+	if skewed {
+		s.Skewed++
+	}
+
+	if skew > s.MaxAhead {
+		s.MaxAhead = skew
+	}
+
+	if -skew > s.MaxBehind {
+		s.MaxBehind = -skew
+	}
+}
+
+// sweep drops the stats of idle sources. Their skewed envelopes are still
+// part of TotalSkewed. It must be called with the lock held.
+func (n *Normalizer) sweep(now time.Time) {
+	for id, s := range n.stats {
+		if now.Sub(s.lastSeen) > idleTimeout {
+			n.evicted += s.Skewed
+			delete(n.stats, id)
+		}
+	}
+	n.lastSweep = now
+}
+
+func integer(i int64) *v2.Value {
+	return &v2.Value{Data: &v2.Value_Integer{Integer: i}}
+}
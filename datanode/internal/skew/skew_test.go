@@ -0,0 +1,148 @@
+package skew_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/skew"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+var now = time.Unix(1000, 0)
+
+func TestNormalizer(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it stamps the ingest timestamp", func(t *testing.T) {
+		n := newNormalizer(skew.Reject)
+		e, err := n.Normalize(envelope(now.Add(-time.Second)))
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.Timestamp).To(Equal(now.Add(-time.Second).UnixNano()))
+		Expect(t, ingest.Timestamp(e)).To(Equal(now.UnixNano()))
+	})
+
+	o.Spec("it rejects skewed envelopes", func(t *testing.T) {
+		n := newNormalizer(skew.Reject)
+		_, err := n.Normalize(envelope(now.Add(2 * time.Minute)))
+		_, ok := err.(*validation.Error)
+		Expect(t, ok).To(BeTrue())
+
+		_, err = n.Normalize(envelope(now.Add(-2 * time.Hour)))
+		Expect(t, err == nil).To(BeFalse())
+	})
+
+	o.Spec("it accepts skewed envelopes", func(t *testing.T) {
+		n := newNormalizer(skew.Accept)
+		e, err := n.Normalize(envelope(now.Add(2 * time.Minute)))
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.Timestamp).To(Equal(now.Add(2 * time.Minute).UnixNano()))
+		Expect(t, e.Tags).To(HaveLen(1))
+	})
+
+	o.Spec("it clamps skewed envelopes", func(t *testing.T) {
+		n := newNormalizer(skew.Clamp)
+		e, err := n.Normalize(envelope(now.Add(2 * time.Minute)))
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.Timestamp).To(Equal(now.UnixNano()))
+		Expect(t, e.Tags[ingest.ProducerTimestampTag].GetInteger()).To(
+			Equal(now.Add(2 * time.Minute).UnixNano()),
+		)
+	})
+
+	o.Spec("it tags skewed envelopes", func(t *testing.T) {
+		n := newNormalizer(skew.Tag)
+		e, err := n.Normalize(envelope(now.Add(2 * time.Minute)))
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.Tags[ingest.SkewTag].GetInteger()).To(
+			Equal(int64(2 * time.Minute)),
+		)
+	})
+
+	o.Spec("it does not modify the original envelope", func(t *testing.T) {
+		n := newNormalizer(skew.Clamp)
+		e := envelope(now.Add(2 * time.Minute))
+		n.Normalize(e)
+		Expect(t, e.Tags).To(HaveLen(0))
+		Expect(t, e.Timestamp).To(Equal(now.Add(2 * time.Minute).UnixNano()))
+	})
+
+	o.Spec("it keeps skew stats per source", func(t *testing.T) {
+		n := newNormalizer(skew.Accept)
+		n.Normalize(envelope(now.Add(2 * time.Minute)))
+		n.Normalize(envelope(now.Add(-time.Second)))
+		other := envelope(now)
+		other.SourceId = "other-id"
+		n.Normalize(other)
+
+		stats := n.Stats("some-id")
+		Expect(t, stats).To(HaveLen(1))
+		Expect(t, stats[0].Count).To(Equal(uint64(2)))
+		Expect(t, stats[0].Skewed).To(Equal(uint64(1)))
+		Expect(t, stats[0].MaxAhead).To(Equal(2 * time.Minute))
+		Expect(t, stats[0].MaxBehind).To(Equal(time.Second))
+		Expect(t, stats[0].Last).To(Equal(-time.Second))
+
+		Expect(t, n.Stats("")).To(HaveLen(2))
+		Expect(t, n.TotalSkewed()).To(Equal(uint64(1)))
+	})
+
+	o.Spec("it drops the stats of idle sources", func(t *testing.T) {
+		current := now
+		n := skew.NewNormalizer(skew.Accept, time.Minute, 0, func() time.Time {
+			return current
+		})
+		n.Normalize(envelope(current.Add(2 * time.Minute)))
+
+		current = current.Add(2 * time.Hour)
+		other := envelope(current)
+		other.SourceId = "other-id"
+		n.Normalize(other)
+
+		stats := n.Stats("")
+		Expect(t, stats).To(HaveLen(1))
+		Expect(t, stats[0].SourceID).To(Equal("other-id"))
+		Expect(t, n.TotalSkewed()).To(Equal(uint64(1)))
+	})
+
+	o.Spec("it parses policies", func(t *testing.T) {
+		p, err := skew.ParsePolicy("clamp")
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, p).To(Equal(skew.Clamp))
+
+		_, err = skew.ParsePolicy("invalid")
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func newNormalizer(p skew.Policy) *skew.Normalizer {
+	return skew.NewNormalizer(p, time.Minute, time.Hour, func() time.Time {
+		return now
+	})
+}
+
+func envelope(t time.Time) *v2.Envelope {
+	return &v2.Envelope{
+		SourceId:  "some-id",
+		Timestamp: t.UnixNano(),
+	}
+}
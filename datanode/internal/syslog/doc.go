@@ -0,0 +1,3 @@
+package syslog
+
+//go:generate hel
@@ -0,0 +1,178 @@
+package syslog
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// maxMessageSize is the largest syslog message read from a connection or
+// datagram.
+const maxMessageSize = 64 * 1024
+
+// errTooLong is returned for a newline framed message that does not fit
+// in maxMessageSize. The message is skipped.
+var errTooLong = fmt.Errorf("syslog message is longer than %d bytes", maxMessageSize)
+
+type WriteFetcher interface {
+	Writer() (writer func(batch [][]byte) (errs []error), err error)
+}
+
+// StartTCP accepts syslog messages over TCP. Messages are framed with
+// either octet counting or a trailing newline (RFC 6587). If tlsConfig is
+// not nil, connections use TLS.
+func StartTCP(addr string, tlsConfig *tls.Config, writer WriteFetcher) (actualAddr string, err error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				log.Printf("Failed to accept syslog connection: %s", err)
+				return
+			}
+
+			go handleConn(conn, writer)
+		}
+	}()
+
+	return lis.Addr().String(), nil
+}
+
+// StartUDP accepts syslog messages over UDP. Each datagram is one message.
+func StartUDP(addr string, writer WriteFetcher) (actualAddr string, err error) {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	w, err := writer.Writer()
+	if err != nil {
+		conn.Close()
+		return "", err
+	}
+
+	go func() {
+		buf := make([]byte, maxMessageSize)
+		for {
+			n, _, err := conn.ReadFrom(buf)
+			if err != nil {
+				log.Printf("Failed to read syslog datagram: %s", err)
+				return
+			}
+
+			write(w, buf[:n])
+		}
+	}()
+
+	return conn.LocalAddr().String(), nil
+}
+
+func handleConn(conn net.Conn, writer WriteFetcher) {
+	defer conn.Close()
+
+	w, err := writer.Writer()
+	if err != nil {
+		log.Printf("Failed to fetch writer for syslog connection: %s", err)
+		return
+	}
+
+	r := bufio.NewReaderSize(conn, maxMessageSize)
+	for {
+		msg, err := readFrame(r)
+		if err == io.EOF {
+			return
+		}
+
+		if err == errTooLong {
+			log.Printf("Skipping syslog message from %s: %s", conn.RemoteAddr(), err)
+			continue
+		}
+
+		if err != nil {
+			log.Printf("Failed to read syslog message from %s: %s", conn.RemoteAddr(), err)
+			return
+		}
+
+		write(w, msg)
+	}
+}
+
+// readFrame reads an octet counted frame if the message starts with a
+// digit. Otherwise the frame ends with a newline. A newline framed message
+// that does not fit in the reader is discarded up to the newline.
+func readFrame(r *bufio.Reader) ([]byte, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if first[0] < '0' || first[0] > '9' {
+		msg, err := r.ReadSlice('\n')
+		if err == io.EOF && len(msg) > 0 {
+			return msg, nil
+		}
+
+		if err == bufio.ErrBufferFull {
+			for err == bufio.ErrBufferFull {
+				_, err = r.ReadSlice('\n')
+			}
+			return nil, errTooLong
+		}
+		return msg, err
+	}
+
+	prefix, err := r.ReadString(' ')
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := strconv.Atoi(prefix[:len(prefix)-1])
+	if err != nil || n <= 0 || n > maxMessageSize {
+		return nil, fmt.Errorf("invalid octet count: %q", prefix)
+	}
+
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func write(w func(batch [][]byte) []error, msg []byte) {
+	if len(bytes.TrimSpace(msg)) == 0 {
+		return
+	}
+
+	e, err := Parse(msg, time.Now)
+	if err != nil {
+		log.Printf("Dropping invalid syslog message: %s", err)
+		return
+	}
+
+	data, err := proto.Marshal(e)
+	if err != nil {
+		log.Printf("Failed to marshal syslog envelope: %s", err)
+		return
+	}
+
+	if errs := w([][]byte{data}); errs[0] != nil {
+		log.Printf("Rejecting syslog message from %s: %s", e.SourceId, errs[0])
+	}
+}
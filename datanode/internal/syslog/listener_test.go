@@ -0,0 +1,100 @@
+package syslog_test
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/poy/eachers/testhelpers"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/syslog"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+type TL struct {
+	*testing.T
+	mockWriteFetcher *mockWriteFetcher
+	batches          chan [][]byte
+}
+
+func TestListener(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TL {
+		mockWriteFetcher := newMockWriteFetcher()
+		batches := make(chan [][]byte, 100)
+		writer := func(batch [][]byte) []error {
+			batches <- batch
+			return make([]error, len(batch))
+		}
+		testhelpers.AlwaysReturn(mockWriteFetcher.WriterOutput.Writer, writer)
+		close(mockWriteFetcher.WriterOutput.Err)
+
+		return TL{
+			T:                t,
+			mockWriteFetcher: mockWriteFetcher,
+			batches:          batches,
+		}
+	})
+
+	o.Spec("it writes newline and octet counted messages from TCP", func(t TL) {
+		addr, err := syslog.StartTCP("127.0.0.1:0", nil, t.mockWriteFetcher)
+		Expect(t, err == nil).To(BeTrue())
+
+		conn, err := net.Dial("tcp", addr)
+		Expect(t, err == nil).To(BeTrue())
+		defer conn.Close()
+
+		counted := "<14>1 - host app-b - - - b"
+		fmt.Fprintf(conn, "<14>1 - host app-a - - - a\n%d %s", len(counted), counted)
+
+		Expect(t, sourceID(t, t.batches)).To(Equal("app-a"))
+		Expect(t, sourceID(t, t.batches)).To(Equal("app-b"))
+	})
+
+	o.Spec("it skips newline framed messages that are too long", func(t TL) {
+		addr, err := syslog.StartTCP("127.0.0.1:0", nil, t.mockWriteFetcher)
+		Expect(t, err == nil).To(BeTrue())
+
+		conn, err := net.Dial("tcp", addr)
+		Expect(t, err == nil).To(BeTrue())
+		defer conn.Close()
+
+		long := "<14>1 - host app-long - - - " + strings.Repeat("x", 100*1024)
+		fmt.Fprintf(conn, "%s\n<14>1 - host app-a - - - a\n", long)
+
+		Expect(t, sourceID(t, t.batches)).To(Equal("app-a"))
+	})
+
+	o.Spec("it writes messages from UDP", func(t TL) {
+		addr, err := syslog.StartUDP("127.0.0.1:0", t.mockWriteFetcher)
+		Expect(t, err == nil).To(BeTrue())
+
+		conn, err := net.Dial("udp", addr)
+		Expect(t, err == nil).To(BeTrue())
+		defer conn.Close()
+
+		_, err = conn.Write([]byte("<14>1 - host app-a - - - a"))
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, sourceID(t, t.batches)).To(Equal("app-a"))
+	})
+}
+
+func sourceID(t TL, batches chan [][]byte) string {
+	var batch [][]byte
+	Expect(t, batches).To(ViaPolling(
+		Chain(Receive(), Fetch(&batch)),
+	))
+	Expect(t, batch).To(HaveLen(1))
+
+	var e v2.Envelope
+	Expect(t, proto.Unmarshal(batch[0], &e) == nil).To(BeTrue())
+	return e.SourceId
+}
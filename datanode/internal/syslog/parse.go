@@ -0,0 +1,284 @@
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+// Tags set on envelopes parsed from syslog messages. Structured data
+// parameters are tagged as <sd-id>.<param-name>.
+const (
+	HostnameTag = "hostname"
+	AppNameTag  = "app_name"
+	ProcIDTag   = "proc_id"
+	MsgIDTag    = "msg_id"
+	FacilityTag = "facility"
+	SeverityTag = "severity"
+)
+
+const nilValue = "-"
+
+// Parse converts an RFC 5424 message into a log envelope. Messages that are
+// not RFC 5424 are parsed as RFC 3164. The source ID is the app name, or the
+// hostname if there is no app name. Messages with a severity of error or
+// worse are ERR logs. now is used when the message has no timestamp.
+func Parse(msg []byte, now func() time.Time) (*v2.Envelope, error) {
+	msg = bytes.TrimRight(msg, "\r\n\x00")
+	pri, rest, err := parsePriority(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var m message
+	if bytes.HasPrefix(rest, []byte("1 ")) {
+		m, err = parse5424(rest[2:], now)
+	} else {
+		m, err = parse3164(rest, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m.envelope(pri), nil
+}
+
+type message struct {
+	timestamp time.Time
+	hostname  string
+	appName   string
+	procID    string
+	msgID     string
+	data      map[string]string
+	payload   []byte
+}
+
+func (m message) envelope(pri int) *v2.Envelope {
+	facility, severity := pri/8, pri%8
+
+	tags := map[string]*v2.Value{
+		FacilityTag: text(strconv.Itoa(facility)),
+		SeverityTag: text(strconv.Itoa(severity)),
+	}
+	for k, v := range map[string]string{
+		HostnameTag: m.hostname,
+		AppNameTag:  m.appName,
+		ProcIDTag:   m.procID,
+		MsgIDTag:    m.msgID,
+	} {
+		if v != "" {
+			tags[k] = text(v)
+		}
+	}
+	for k, v := range m.data {
+		tags[k] = text(v)
+	}
+
+	sourceID := m.appName
+	if sourceID == "" {
+		sourceID = m.hostname
+	}
+
+	logType := v2.Log_OUT
+	if severity <= 3 {
+		logType = v2.Log_ERR
+	}
+
+	return &v2.Envelope{
+		Timestamp: m.timestamp.UnixNano(),
+		SourceId:  sourceID,
+		Tags:      tags,
+		Message: &v2.Envelope_Log{
+			Log: &v2.Log{
+				Payload: m.payload,
+				Type:    logType,
+			},
+		},
+	}
+}
+
+func text(s string) *v2.Value {
+	return &v2.Value{Data: &v2.Value_Text{Text: s}}
+}
+
+func parsePriority(msg []byte) (int, []byte, error) {
+	end := bytes.IndexByte(msg, '>')
+	if len(msg) == 0 || msg[0] != '<' || end < 2 || end > 4 {
+		return 0, nil, fmt.Errorf("missing priority")
+	}
+
+	pri, err := strconv.Atoi(string(msg[1:end]))
+	if err != nil || pri < 0 || pri > 191 {
+		return 0, nil, fmt.Errorf("invalid priority: %s", msg[1:end])
+	}
+
+	return pri, msg[end+1:], nil
+}
+
+func parse5424(msg []byte, now func() time.Time) (message, error) {
+	var (
+		fields [5]string
+		m      message
+	)
+	for i := range fields {
+		var ok bool
+		fields[i], msg, ok = nextField(msg)
+		if !ok {
+			return message{}, fmt.Errorf("truncated RFC 5424 header")
+		}
+	}
+
+	m.timestamp = now()
+	if fields[0] != nilValue {
+		t, err := time.Parse(time.RFC3339Nano, fields[0])
+		if err != nil {
+			return message{}, fmt.Errorf("invalid timestamp: %s", fields[0])
+		}
+		m.timestamp = t
+	}
+	m.hostname = nilToEmpty(fields[1])
+	m.appName = nilToEmpty(fields[2])
+	m.procID = nilToEmpty(fields[3])
+	m.msgID = nilToEmpty(fields[4])
+
+	data, msg, err := parseStructuredData(msg)
+	if err != nil {
+		return message{}, err
+	}
+	m.data = data
+
+	if len(msg) > 0 {
+		if msg[0] != ' ' {
+			return message{}, fmt.Errorf("expected space after structured data")
+		}
+		msg = bytes.TrimPrefix(msg[1:], []byte("\xEF\xBB\xBF"))
+	}
+	m.payload = msg
+
+	return m, nil
+}
+
+func nextField(msg []byte) (field string, rest []byte, ok bool) {
+	i := bytes.IndexByte(msg, ' ')
+	if i <= 0 {
+		return "", nil, false
+	}
+	return string(msg[:i]), msg[i+1:], true
+}
+
+func nilToEmpty(s string) string {
+	if s == nilValue {
+		return ""
+	}
+	return s
+}
+
+func parseStructuredData(msg []byte) (map[string]string, []byte, error) {
+	if bytes.HasPrefix(msg, []byte(nilValue)) {
+		return nil, msg[1:], nil
+	}
+
+	data := make(map[string]string)
+	for len(msg) > 0 && msg[0] == '[' {
+		end := bytes.IndexAny(msg, " ]")
+		if end <= 1 {
+			return nil, nil, fmt.Errorf("invalid structured data")
+		}
+		id := string(msg[1:end])
+		msg = msg[end:]
+
+		for len(msg) > 0 && msg[0] == ' ' {
+			eq := bytes.IndexByte(msg, '=')
+			if eq <= 1 || eq+1 >= len(msg) || msg[eq+1] != '"' {
+				return nil, nil, fmt.Errorf("invalid structured data parameter in %s", id)
+			}
+			name := string(msg[1:eq])
+
+			value, rest, err := parseParamValue(msg[eq+2:])
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s in %s", err, id)
+			}
+			data[id+"."+name] = value
+			msg = rest
+		}
+
+		if len(msg) == 0 || msg[0] != ']' {
+			return nil, nil, fmt.Errorf("unterminated structured data element %s", id)
+		}
+		msg = msg[1:]
+	}
+
+	if len(data) == 0 {
+		return nil, nil, fmt.Errorf("invalid structured data")
+	}
+
+	return data, msg, nil
+}
+
+func parseParamValue(msg []byte) (string, []byte, error) {
+	var value []byte
+	for i := 0; i < len(msg); i++ {
+		switch msg[i] {
+		case '\\':
+			if i+1 < len(msg) && (msg[i+1] == '"' || msg[i+1] == '\\' || msg[i+1] == ']') {
+				i++
+			}
+		case '"':
+			return string(value), msg[i+1:], nil
+		}
+		value = append(value, msg[i])
+	}
+
+	return "", nil, fmt.Errorf("unterminated parameter value")
+}
+
+// parse3164 parses the BSD syslog format:
+// "Mmm dd hh:mm:ss hostname tag[pid]: message". The year is taken from now.
+// Anything that does not match is used as the payload.
+func parse3164(msg []byte, now func() time.Time) (message, error) {
+	current := now()
+	m := message{
+		timestamp: current,
+		payload:   msg,
+	}
+
+	const stampLen = len(time.Stamp)
+	if len(msg) < stampLen+1 || msg[stampLen] != ' ' {
+		return m, nil
+	}
+
+	t, err := time.ParseInLocation(time.Stamp, string(msg[:stampLen]), current.Location())
+	if err != nil {
+		return m, nil
+	}
+	m.timestamp = t.AddDate(current.Year(), 0, 0)
+	if m.timestamp.After(current.AddDate(0, 1, 0)) {
+		m.timestamp = m.timestamp.AddDate(-1, 0, 0)
+	}
+	msg = msg[stampLen+1:]
+
+	hostname, rest, ok := nextField(msg)
+	if !ok {
+		m.payload = msg
+		return m, nil
+	}
+	m.hostname = hostname
+	msg = rest
+
+	if i := bytes.IndexByte(msg, ':'); i > 0 && bytes.IndexByte(msg[:i], ' ') < 0 {
+		tag := string(msg[:i])
+		if open := strings.IndexByte(tag, '['); open > 0 && strings.HasSuffix(tag, "]") {
+			m.procID = tag[open+1 : len(tag)-1]
+			tag = tag[:open]
+		}
+		m.appName = tag
+		msg = bytes.TrimPrefix(msg[i+1:], []byte(" "))
+	}
+	m.payload = msg
+
+	return m, nil
+}
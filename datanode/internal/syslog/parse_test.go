@@ -0,0 +1,121 @@
+package syslog_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/syslog"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TP struct {
+	*testing.T
+	now func() time.Time
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TP {
+		return TP{
+			T: t,
+			now: func() time.Time {
+				return time.Date(2017, time.March, 10, 12, 0, 0, 0, time.UTC)
+			},
+		}
+	})
+
+	o.Spec("it parses RFC 5424 messages", func(t TP) {
+		msg := `<165>1 2017-03-01T22:14:15.003Z lb-1 haproxy 8710 ID47 [req@32473 path="/a\"b" status="200"] some message`
+		e, err := syslog.Parse([]byte(msg), t.now)
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, e.SourceId).To(Equal("haproxy"))
+		Expect(t, e.Timestamp).To(Equal(time.Date(2017, time.March, 1, 22, 14, 15, 3000000, time.UTC).UnixNano()))
+		Expect(t, e.GetLog().Payload).To(Equal([]byte("some message")))
+		Expect(t, e.GetLog().Type).To(Equal(v2.Log_OUT))
+		Expect(t, tags(e)).To(Equal(map[string]string{
+			"hostname":         "lb-1",
+			"app_name":         "haproxy",
+			"proc_id":          "8710",
+			"msg_id":           "ID47",
+			"facility":         "20",
+			"severity":         "5",
+			"req@32473.path":   `/a"b`,
+			"req@32473.status": "200",
+		}))
+	})
+
+	o.Spec("it uses the hostname and current time when they are missing", func(t TP) {
+		e, err := syslog.Parse([]byte("<11>1 - db-1 - - - -"), t.now)
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, e.SourceId).To(Equal("db-1"))
+		Expect(t, e.Timestamp).To(Equal(t.now().UnixNano()))
+		Expect(t, e.GetLog().Type).To(Equal(v2.Log_ERR))
+		Expect(t, e.GetLog().Payload).To(HaveLen(0))
+	})
+
+	o.Spec("it falls back to RFC 3164", func(t TP) {
+		e, err := syslog.Parse([]byte("<34>Mar  9 22:14:15 db-1 postgres[123]: some message\n"), t.now)
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, e.SourceId).To(Equal("postgres"))
+		Expect(t, e.Timestamp).To(Equal(time.Date(2017, time.March, 9, 22, 14, 15, 0, time.UTC).UnixNano()))
+		Expect(t, e.GetLog().Payload).To(Equal([]byte("some message")))
+		Expect(t, e.GetLog().Type).To(Equal(v2.Log_ERR))
+		Expect(t, tags(e)).To(Equal(map[string]string{
+			"hostname": "db-1",
+			"app_name": "postgres",
+			"proc_id":  "123",
+			"facility": "4",
+			"severity": "2",
+		}))
+	})
+
+	o.Spec("it uses the previous year for RFC 3164 timestamps far in the future", func(t TP) {
+		e, err := syslog.Parse([]byte("<34>Dec 31 23:59:59 db-1 postgres: some message"), t.now)
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.Timestamp).To(Equal(time.Date(2016, time.December, 31, 23, 59, 59, 0, time.UTC).UnixNano()))
+	})
+
+	o.Spec("it returns an error for invalid messages", func(t TP) {
+		for _, msg := range []string{
+			"no priority",
+			"<999>1 - - - - - -",
+			"<14>1 not-a-time host app - - -",
+			"<14>1 - host app - -",
+			`<14>1 - host app - - [id a="b"`,
+			`<14>1 - host app - - [id a="b]`,
+		} {
+			_, err := syslog.Parse([]byte(msg), t.now)
+			Expect(t, err == nil).To(BeFalse())
+		}
+	})
+}
+
+func tags(e *v2.Envelope) map[string]string {
+	m := make(map[string]string)
+	for k, v := range e.Tags {
+		m[k] = v.GetText()
+	}
+	return m
+}
@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+// Error is returned for payloads that are not valid envelopes.
+type Error struct {
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return e.Reason
+}
+
+// Validator checks payloads before they are routed.
+type Validator struct {
+	maxPayloadSize int
+}
+
+// New returns a Validator. Payloads larger than maxPayloadSize are
+// rejected.
+func New(maxPayloadSize int) *Validator {
+	return &Validator{
+		maxPayloadSize: maxPayloadSize,
+	}
+}
+
+// Validate unmarshals the payload and checks the envelope. It returns an
+// *Error when the payload is invalid.
+func (v *Validator) Validate(data []byte) (*v2.Envelope, error) {
+	if len(data) > v.maxPayloadSize {
+		return nil, &Error{Reason: fmt.Sprintf("payload size %d exceeds %d bytes", len(data), v.maxPayloadSize)}
+	}
+
+	var e v2.Envelope
+	if err := proto.Unmarshal(data, &e); err != nil {
+		return nil, &Error{Reason: fmt.Sprintf("invalid envelope: %s", err)}
+	}
+
+	if e.SourceId == "" {
+		return nil, &Error{Reason: "missing source_id"}
+	}
+
+	if e.Timestamp <= 0 {
+		return nil, &Error{Reason: fmt.Sprintf("invalid timestamp: %d", e.Timestamp)}
+	}
+
+	switch e.Message.(type) {
+	case *v2.Envelope_Log, *v2.Envelope_Counter, *v2.Envelope_Gauge, *v2.Envelope_Timer:
+	default:
+		return nil, &Error{Reason: "unknown message type"}
+	}
+
+	return &e, nil
+}
@@ -0,0 +1,100 @@
+package validation_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TV struct {
+	*testing.T
+	v   *validation.Validator
+	now time.Time
+}
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TV {
+		now := time.Unix(1000, 0)
+		return TV{
+			T:   t,
+			now: now,
+			v:   validation.New(100),
+		}
+	})
+
+	o.Spec("it returns a valid envelope", func(t TV) {
+		e, err := t.v.Validate(marshal(t, validEnvelope(t.now)))
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.SourceId).To(Equal("some-id"))
+	})
+
+	o.Spec("it rejects invalid payloads", func(t TV) {
+		tooLarge := validEnvelope(t.now)
+		tooLarge.GetLog().Payload = []byte(strings.Repeat("x", 100))
+
+		noSource := validEnvelope(t.now)
+		noSource.SourceId = ""
+
+		noTimestamp := validEnvelope(t.now)
+		noTimestamp.Timestamp = 0
+
+		noMessage := validEnvelope(t.now)
+		noMessage.Message = nil
+
+		payloads := [][]byte{
+			[]byte("invalid"),
+			marshal(t, tooLarge),
+			marshal(t, noSource),
+			marshal(t, noTimestamp),
+			marshal(t, noMessage),
+		}
+
+		for _, p := range payloads {
+			_, err := t.v.Validate(p)
+			_, ok := err.(*validation.Error)
+			Expect(t, ok).To(BeTrue())
+		}
+	})
+}
+
+func validEnvelope(now time.Time) *v2.Envelope {
+	return &v2.Envelope{
+		SourceId:  "some-id",
+		Timestamp: now.UnixNano(),
+		Message: &v2.Envelope_Log{
+			Log: &v2.Log{Payload: []byte("some-log")},
+		},
+	}
+}
+
+func marshal(t TV, e *v2.Envelope) []byte {
+	data, err := proto.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -1,14 +1,30 @@
 package main
 
 import (
+	"crypto/tls"
+	"expvar"
 	"log"
+	"math/rand"
 	"net/http"
+	"time"
 
 	"github.com/poy/loggrebutterfly/datanode/internal/config"
+	"github.com/poy/loggrebutterfly/datanode/internal/deadletter"
+	"github.com/poy/loggrebutterfly/datanode/internal/dedup"
 	"github.com/poy/loggrebutterfly/datanode/internal/filesystem"
-	"github.com/poy/loggrebutterfly/datanode/internal/hasher"
+	"github.com/poy/loggrebutterfly/datanode/internal/httpingress"
+	"github.com/poy/loggrebutterfly/datanode/internal/otlp"
+	"github.com/poy/loggrebutterfly/datanode/internal/pipeline"
+	"github.com/poy/loggrebutterfly/datanode/internal/promwrite"
+	"github.com/poy/loggrebutterfly/datanode/internal/ratelimit"
 	"github.com/poy/loggrebutterfly/datanode/internal/server"
 	"github.com/poy/loggrebutterfly/datanode/internal/server/intra"
+	"github.com/poy/loggrebutterfly/datanode/internal/skew"
+	"github.com/poy/loggrebutterfly/datanode/internal/syslog"
+	"github.com/poy/loggrebutterfly/datanode/internal/validation"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+	"github.com/poy/loggrebutterfly/internal/hlc"
+	"github.com/poy/loggrebutterfly/internal/sequence"
 	"github.com/poy/petasos/router"
 
 	_ "net/http/pprof"
@@ -20,20 +36,80 @@ func main() {
 
 	conf := config.Load()
 
-	fs := filesystem.New(conf.NodeAddr)
-	hasher := hasher.New()
+	fs := filesystem.New(conf.NodeAddr, conf.HashVersion)
+	hasher, err := hashing.New(conf.HashVersion, conf.HotSources)
+	if err != nil {
+		log.Fatalf("Invalid HASH_VERSION: %s", err)
+	}
 	counter := router.NewCounter()
-	routerFetcher := server.NewRouterFetcher(fs, hasher, counter)
+	tracker := sequence.NewTracker()
+	dedupFS := dedup.NewFileSystem(fs, conf.DedupWindowSize, conf.DedupContentHash, tracker)
+	validator := validation.New(conf.MaxPayloadSize)
+	transformer, err := pipeline.Load(conf.PipelineConfig, rand.Float64)
+	if err != nil {
+		log.Fatalf("Invalid PIPELINE_CONFIG: %s", err)
+	}
+	normalizer := skew.NewNormalizer(conf.SkewPolicy, conf.MaxFutureSkew, conf.MaxPastSkew, time.Now)
+	limiter := ratelimit.New(
+		ratelimit.Limit{Rate: float64(conf.RateLimit), Burst: conf.RateLimitBurst},
+		conf.RateLimits,
+		time.Now,
+	)
+	deadLetters := deadletter.NewBuffer(conf.DeadLetterSize)
+	publishTotals(tracker, dedupFS, normalizer, limiter, transformer)
+	routerFetcher := server.NewRouterFetcher(
+		dedupFS,
+		hasher,
+		counter,
+		validator,
+		transformer,
+		normalizer,
+		hlc.NewClock(conf.NodeID, time.Now),
+		limiter,
+		deadLetters,
+	)
 
 	log.Printf("Starting server on %s...", conf.Addr)
-	addr, err := server.Start(conf.Addr, routerFetcher, fs)
+	var opts []server.Option
+	if conf.DedupWindowSize > 0 {
+		opts = append(opts, server.WithDeduplication())
+	}
+	addr, err := server.Start(conf.Addr, routerFetcher, fs, opts...)
 	if err != nil {
 		log.Fatalf("Failed to start server: %s", err)
 	}
 	log.Printf("Started server on %s.", addr)
 
+	startSyslog(conf, routerFetcher)
+	startOTLP(conf, routerFetcher)
+
+	if conf.PromWriteAddr != "" {
+		promAddr, err := promwrite.Start(conf.PromWriteAddr, promwrite.New(routerFetcher, conf.PromSourceLabel))
+		if err != nil {
+			log.Fatalf("Failed to start Prometheus remote write receiver: %s", err)
+		}
+		log.Printf("Started Prometheus remote write receiver on %s.", promAddr)
+	}
+
+	if conf.HTTPAddr != "" {
+		httpAddr, err := httpingress.Start(conf.HTTPAddr, routerFetcher)
+		if err != nil {
+			log.Fatalf("Failed to start HTTP ingest: %s", err)
+		}
+		log.Printf("Started HTTP ingest on %s.", httpAddr)
+	}
+
 	log.Printf("Starting intra server on %s...", conf.IntraAddr)
-	intraAddr, err := intra.Start(conf.IntraAddr, counter)
+	intraAddr, err := intra.Start(
+		conf.IntraAddr,
+		counter,
+		tracker,
+		dedupFS,
+		limiter,
+		deadLetters,
+		routerFetcher,
+		normalizer,
+	)
 	if err != nil {
 		log.Fatalf("Failed to start intra server: %s", err)
 	}
@@ -42,3 +118,92 @@ func main() {
 	log.Printf("Starting pprof on %s", conf.PprofAddr)
 	log.Println(http.ListenAndServe(conf.PprofAddr, nil))
 }
+
+func startSyslog(conf config.Config, writer syslog.WriteFetcher) {
+	if conf.SyslogTCPAddr != "" {
+		var tlsConfig *tls.Config
+		if conf.SyslogCertFile != "" {
+			cert, err := tls.LoadX509KeyPair(conf.SyslogCertFile, conf.SyslogKeyFile)
+			if err != nil {
+				log.Fatalf("Failed to load syslog certificate: %s", err)
+			}
+			tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+		}
+
+		addr, err := syslog.StartTCP(conf.SyslogTCPAddr, tlsConfig, writer)
+		if err != nil {
+			log.Fatalf("Failed to start syslog TCP listener: %s", err)
+		}
+		log.Printf("Started syslog TCP listener on %s.", addr)
+	}
+
+	if conf.SyslogUDPAddr != "" {
+		addr, err := syslog.StartUDP(conf.SyslogUDPAddr, writer)
+		if err != nil {
+			log.Fatalf("Failed to start syslog UDP listener: %s", err)
+		}
+		log.Printf("Started syslog UDP listener on %s.", addr)
+	}
+}
+
+func startOTLP(conf config.Config, writer otlp.WriteFetcher) {
+	s := otlp.New(writer, otlp.NewConverter(conf.OTLPSourceIDAttribute))
+
+	if conf.OTLPGRPCAddr != "" {
+		addr, err := otlp.Start(conf.OTLPGRPCAddr, s)
+		if err != nil {
+			log.Fatalf("Failed to start OTLP/gRPC server: %s", err)
+		}
+		log.Printf("Started OTLP/gRPC server on %s.", addr)
+	}
+
+	if conf.OTLPHTTPAddr != "" {
+		addr, err := otlp.StartHTTP(conf.OTLPHTTPAddr, s)
+		if err != nil {
+			log.Fatalf("Failed to start OTLP/HTTP server: %s", err)
+		}
+		log.Printf("Started OTLP/HTTP server on %s.", addr)
+	}
+}
+
+func publishTotals(
+	tracker *sequence.Tracker,
+	dedupFS *dedup.FileSystem,
+	normalizer *skew.Normalizer,
+	limiter *ratelimit.Limiter,
+	transformer *pipeline.Pipeline,
+) {
+	expvar.Publish("sequence", expvar.Func(func() interface{} {
+		gaps, reorders := tracker.Totals()
+		return map[string]uint64{
+			"gaps":     gaps,
+			"reorders": reorders,
+		}
+	}))
+
+	expvar.Publish("dedup", expvar.Func(func() interface{} {
+		return map[string]uint64{
+			"duplicates": dedupFS.TotalDuplicates(),
+		}
+	}))
+
+	expvar.Publish("skew", expvar.Func(func() interface{} {
+		return map[string]uint64{
+			"skewed": normalizer.TotalSkewed(),
+		}
+	}))
+
+	expvar.Publish("ratelimit", expvar.Func(func() interface{} {
+		return map[string]uint64{
+			"throttled": limiter.TotalThrottled(),
+		}
+	}))
+
+	expvar.Publish("pipeline", expvar.Func(func() interface{} {
+		dropped, sampled := transformer.Totals()
+		return map[string]uint64{
+			"dropped": dropped,
+			"sampled": sampled,
+		}
+	}))
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"log"
+	"time"
+
+	"github.com/bradylove/envstruct"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+)
+
+type Config struct {
+	MasterAddr string `env:"MASTER_ADDR,required"`
+	PprofAddr  string `env:"PPROF_ADDR"`
+
+	// DrainsConfig is the path to a JSON file with the drains. No drains
+	// are started if it is empty.
+	DrainsConfig string `env:"DRAINS_CONFIG"`
+
+	// EgressAddr is the address for the loggregator v2 Egress service. The
+	// service is disabled if it is empty.
+	EgressAddr string `env:"EGRESS_ADDR"`
+
+	// FirehoseAddr is the address for the Firehose service. The service is
+	// disabled if it is empty.
+	FirehoseAddr string `env:"FIREHOSE_ADDR"`
+
+	// CheckpointFile is where the position of each drain is saved. The
+	// drains start from the beginning of each range if it is empty.
+	CheckpointFile string `env:"CHECKPOINT_FILE"`
+
+	// CheckpointInterval is how often the checkpoints are saved.
+	CheckpointInterval time.Duration `env:"CHECKPOINT_INTERVAL"`
+
+	// PollInterval is how often the ranges are listed and how long a
+	// drain waits before reading a range again.
+	PollInterval time.Duration `env:"POLL_INTERVAL"`
+
+	// Hostname is the hostname in the drained syslog messages.
+	Hostname string `env:"HOSTNAME"`
+
+	// SkipCertVerify disables certificate verification for syslog-tls
+	// drains.
+	SkipCertVerify bool `env:"SKIP_CERT_VERIFY"`
+
+	// HotSourceList is a list of source_id:tag pairs. It must match the
+	// data nodes' HOT_SOURCE_LIST.
+	HotSourceList []string `env:"HOT_SOURCE_LIST"`
+
+	HotSources map[string]string
+}
+
+func Load() Config {
+	conf := Config{
+		PprofAddr:          "localhost:0",
+		CheckpointInterval: 5 * time.Second,
+		PollInterval:       time.Second,
+	}
+	if err := envstruct.Load(&conf); err != nil {
+		log.Fatalf("Unable to load config: %s", err)
+	}
+
+	hotSources, err := hashing.ParseHotSources(conf.HotSourceList)
+	if err != nil {
+		log.Fatalf("Invalid HOT_SOURCE_LIST: %s", err)
+	}
+	conf.HotSources = hotSources
+
+	return conf
+}
@@ -0,0 +1,104 @@
+package drain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoints records the next index to read for each drain and range. They
+// are kept in memory and saved to a JSON file.
+type Checkpoints struct {
+	path string
+
+	mu    sync.Mutex
+	next  map[string]map[string]uint64
+	dirty bool
+}
+
+// LoadCheckpoints reads the checkpoints from path. A missing file has no
+// checkpoints. An empty path keeps the checkpoints in memory only.
+func LoadCheckpoints(path string) (*Checkpoints, error) {
+	c := &Checkpoints{
+		path: path,
+		next: make(map[string]map[string]uint64),
+	}
+
+	if path == "" {
+		return c, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &c.next); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Next returns the next index to read from the file for the drain.
+func (c *Checkpoints) Next(drain, file string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.next[drain][file]
+}
+
+func (c *Checkpoints) Set(drain, file string, next uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.next[drain] == nil {
+		c.next[drain] = make(map[string]uint64)
+	}
+	c.next[drain][file] = next
+	c.dirty = true
+}
+
+// Save writes the checkpoints if they changed since the last save. The
+// file is replaced atomically.
+func (c *Checkpoints) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.path == "" || !c.dirty {
+		return nil
+	}
+
+	data, err := json.Marshal(c.next)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(c.path), filepath.Base(c.path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return err
+	}
+
+	c.dirty = false
+	return nil
+}
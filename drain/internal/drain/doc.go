@@ -0,0 +1,3 @@
+package drain
+
+//go:generate hel
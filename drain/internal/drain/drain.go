@@ -0,0 +1,98 @@
+package drain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/golang/protobuf/jsonpb"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/envfilter"
+)
+
+// Config is the file format of the drains.
+type Config struct {
+	Drains []DrainConfig `json:"drains"`
+}
+
+// DrainConfig is a single drain. The filter is an AnalystFilter in its
+// JSON form and has the same semantics as an analyst query. A drain
+// without a filter forwards every envelope. The name identifies the
+// drain's checkpoints and must be unique.
+type DrainConfig struct {
+	Name   string          `json:"name"`
+	URL    string          `json:"url"`
+	Filter json.RawMessage `json:"filter"`
+}
+
+type Filter interface {
+	Keep(e *v2.Envelope) bool
+}
+
+// Drain is what a Worker follows. An empty SourceID follows every range.
+type Drain struct {
+	Name     string
+	URL      *url.URL
+	SourceID string
+	Filter   Filter
+}
+
+// Load reads the drains from a JSON file.
+func Load(path string) ([]Drain, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	return New(c)
+}
+
+func New(c Config) ([]Drain, error) {
+	var drains []Drain
+	names := make(map[string]bool)
+	for i, dc := range c.Drains {
+		if dc.Name == "" {
+			return nil, fmt.Errorf("drain %d: missing name", i)
+		}
+
+		if names[dc.Name] {
+			return nil, fmt.Errorf("drain %d: duplicate name %s", i, dc.Name)
+		}
+		names[dc.Name] = true
+
+		u, err := url.Parse(dc.URL)
+		if err != nil {
+			return nil, fmt.Errorf("drain %s: invalid url: %s", dc.Name, err)
+		}
+
+		var filter *v1.AnalystFilter
+		if len(dc.Filter) > 0 {
+			filter = new(v1.AnalystFilter)
+			if err := jsonpb.Unmarshal(bytes.NewReader(dc.Filter), filter); err != nil {
+				return nil, fmt.Errorf("drain %s: invalid filter: %s", dc.Name, err)
+			}
+		}
+
+		f, err := envfilter.New(filter, envfilter.MatchAnySource())
+		if err != nil {
+			return nil, fmt.Errorf("drain %s: invalid filter: %s", dc.Name, err)
+		}
+
+		drains = append(drains, Drain{
+			Name:     dc.Name,
+			URL:      u,
+			SourceID: filter.GetSourceId(),
+			Filter:   f,
+		})
+	}
+
+	return drains, nil
+}
@@ -0,0 +1,83 @@
+package drain_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/drain/internal/drain"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestDrains(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it builds drains from the config", func(t *testing.T) {
+		drains, err := drain.New(drain.Config{
+			Drains: []drain.DrainConfig{
+				{Name: "a", URL: "syslog-tls://siem:6514", Filter: []byte(`{"source_id": "some-id", "log": {}}`)},
+				{Name: "b", URL: "syslog://siem:514"},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, drains).To(HaveLen(2))
+
+		Expect(t, drains[0].SourceID).To(Equal("some-id"))
+		Expect(t, drains[0].URL.Host).To(Equal("siem:6514"))
+		Expect(t, drains[0].Filter.Keep(&v2.Envelope{SourceId: "some-id", Message: &v2.Envelope_Log{Log: &v2.Log{}}})).To(BeTrue())
+		Expect(t, drains[0].Filter.Keep(&v2.Envelope{SourceId: "some-id", Message: &v2.Envelope_Counter{Counter: &v2.Counter{}}})).To(BeFalse())
+
+		Expect(t, drains[1].SourceID).To(Equal(""))
+		Expect(t, drains[1].Filter.Keep(&v2.Envelope{SourceId: "other"})).To(BeTrue())
+	})
+
+	o.Spec("it returns an error for invalid drains", func(t *testing.T) {
+		for _, c := range []drain.DrainConfig{
+			{URL: "syslog://siem:514"},
+			{Name: "a", URL: "syslog://siem:514", Filter: []byte(`{"invalid": true}`)},
+			{Name: "a", URL: "syslog://siem:514", Filter: []byte(`{"log": {"regexp": "["}}`)},
+		} {
+			_, err := drain.New(drain.Config{Drains: []drain.DrainConfig{c}})
+			Expect(t, err == nil).To(BeFalse())
+		}
+
+		_, err := drain.New(drain.Config{
+			Drains: []drain.DrainConfig{
+				{Name: "a", URL: "syslog://siem:514"},
+				{Name: "a", URL: "syslog://siem:514"},
+			},
+		})
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func TestCheckpoints(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it saves and loads checkpoints", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "checkpoints")
+		Expect(t, err == nil).To(BeTrue())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "checkpoints.json")
+
+		c, err := drain.LoadCheckpoints(path)
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, c.Next("some-drain", "some-file")).To(Equal(uint64(0)))
+
+		c.Set("some-drain", "some-file", 99)
+		Expect(t, c.Save() == nil).To(BeTrue())
+
+		c, err = drain.LoadCheckpoints(path)
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, c.Next("some-drain", "some-file")).To(Equal(uint64(99)))
+		Expect(t, c.Next("other-drain", "some-file")).To(Equal(uint64(0)))
+	})
+}
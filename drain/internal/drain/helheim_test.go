@@ -0,0 +1,74 @@
+// This file was generated by github.com/nelsam/hel.  Do not
+// edit this code by hand unless you *really* know what you're
+// doing.  Expect any changes made manually to be overwritten
+// the next time hel regenerates this file.
+
+package drain_test
+
+import (
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+)
+
+type mockFileSystem struct {
+	ListCalled chan bool
+	ListOutput struct {
+		Files chan []string
+		Err   chan error
+	}
+	ReaderCalled chan bool
+	ReaderInput  struct {
+		Name       chan string
+		StartIndex chan uint64
+	}
+	ReaderOutput struct {
+		Reader chan func() (*v1.ReadData, error)
+		Err    chan error
+	}
+}
+
+func newMockFileSystem() *mockFileSystem {
+	m := &mockFileSystem{}
+	m.ListCalled = make(chan bool, 100)
+	m.ListOutput.Files = make(chan []string, 100)
+	m.ListOutput.Err = make(chan error, 100)
+	m.ReaderCalled = make(chan bool, 100)
+	m.ReaderInput.Name = make(chan string, 100)
+	m.ReaderInput.StartIndex = make(chan uint64, 100)
+	m.ReaderOutput.Reader = make(chan func() (*v1.ReadData, error), 100)
+	m.ReaderOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockFileSystem) List() (files []string, err error) {
+	m.ListCalled <- true
+	return <-m.ListOutput.Files, <-m.ListOutput.Err
+}
+func (m *mockFileSystem) Reader(name string, startIndex uint64) (reader func() (*v1.ReadData, error), err error) {
+	m.ReaderCalled <- true
+	m.ReaderInput.Name <- name
+	m.ReaderInput.StartIndex <- startIndex
+	return <-m.ReaderOutput.Reader, <-m.ReaderOutput.Err
+}
+
+type mockWriter struct {
+	WriteCalled chan bool
+	WriteInput  struct {
+		E chan *v2.Envelope
+	}
+	WriteOutput struct {
+		Ret0 chan error
+	}
+}
+
+func newMockWriter() *mockWriter {
+	m := &mockWriter{}
+	m.WriteCalled = make(chan bool, 100)
+	m.WriteInput.E = make(chan *v2.Envelope, 100)
+	m.WriteOutput.Ret0 = make(chan error, 100)
+	return m
+}
+func (m *mockWriter) Write(e *v2.Envelope) error {
+	m.WriteCalled <- true
+	m.WriteInput.E <- e
+	return <-m.WriteOutput.Ret0
+}
@@ -0,0 +1,227 @@
+package drain
+
+import (
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+)
+
+// maxBackoff is the longest wait between delivery attempts.
+const maxBackoff = time.Minute
+
+type FileSystem interface {
+	List() (files []string, err error)
+	Reader(name string, startIndex uint64) (reader func() (*v1.ReadData, error), err error)
+}
+
+type Writer interface {
+	Write(e *v2.Envelope) error
+}
+
+type Checkpointer interface {
+	Next(drain, file string) uint64
+	Set(drain, file string, next uint64)
+}
+
+type Hashers interface {
+	HashRange(version uint64, sourceID string) (low, high uint64, err error)
+}
+
+// Worker follows the ranges that can hold the drain's envelopes and writes
+// the envelopes that match its filter. Each range is read from its
+// checkpoint. An envelope is retried until it is written, so the
+// destination sees every matching envelope of a range in order.
+type Worker struct {
+	drain       Drain
+	fs          FileSystem
+	writer      Writer
+	checkpoints Checkpointer
+	hashers     Hashers
+	interval    time.Duration
+
+	done chan struct{}
+
+	mu        sync.Mutex
+	current   map[string]bool
+	following map[string]bool
+}
+
+func NewWorker(
+	d Drain,
+	fs FileSystem,
+	writer Writer,
+	checkpoints Checkpointer,
+	hashers Hashers,
+	interval time.Duration,
+) *Worker {
+	return &Worker{
+		drain:       d,
+		fs:          fs,
+		writer:      writer,
+		checkpoints: checkpoints,
+		hashers:     hashers,
+		interval:    interval,
+		done:        make(chan struct{}),
+		following:   make(map[string]bool),
+	}
+}
+
+// Start lists the ranges every interval and follows the new ones.
+func (w *Worker) Start() {
+	go func() {
+		for !w.stopped() {
+			w.poll()
+			w.sleep(w.interval)
+		}
+	}()
+}
+
+// Stop stops following the ranges. An envelope that is being delivered is
+// not retried.
+func (w *Worker) Stop() {
+	close(w.done)
+}
+
+func (w *Worker) stopped() bool {
+	select {
+	case <-w.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep waits for d and reports whether the worker is still running.
+func (w *Worker) sleep(d time.Duration) bool {
+	select {
+	case <-w.done:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (w *Worker) poll() {
+	files, err := w.fs.List()
+	if err != nil {
+		log.Printf("Drain %s failed to list ranges: %s", w.drain.Name, err)
+		return
+	}
+
+	current := make(map[string]bool)
+	for _, file := range files {
+		if w.inRange(file) {
+			current[file] = true
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.current = current
+
+	for file := range current {
+		if w.following[file] {
+			continue
+		}
+		w.following[file] = true
+		go w.follow(file)
+	}
+}
+
+// follow reads the file until it is no longer listed. The reader is
+// reopened from the checkpoint after it ends or fails.
+func (w *Worker) follow(file string) {
+	for w.isCurrent(file) {
+		if err := w.readFile(file); err != nil && err != io.EOF {
+			log.Printf("Drain %s failed to read %s: %s", w.drain.Name, file, err)
+		}
+
+		if !w.sleep(w.interval) {
+			return
+		}
+	}
+}
+
+func (w *Worker) isCurrent(file string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.current[file] {
+		delete(w.following, file)
+		return false
+	}
+	return true
+}
+
+func (w *Worker) readFile(file string) error {
+	reader, err := w.fs.Reader(file, w.checkpoints.Next(w.drain.Name, file))
+	if err != nil {
+		return err
+	}
+
+	for !w.stopped() {
+		data, err := reader()
+		if err != nil {
+			return err
+		}
+
+		var e v2.Envelope
+		if err := proto.Unmarshal(data.Payload, &e); err != nil {
+			log.Printf("Drain %s skipping invalid envelope %d in %s: %s", w.drain.Name, data.Index, file, err)
+		} else if w.drain.Filter.Keep(&e) && !w.deliver(&e) {
+			return nil
+		}
+
+		w.checkpoints.Set(w.drain.Name, file, data.Index+1)
+	}
+
+	return nil
+}
+
+// deliver writes the envelope until it succeeds. It returns false if the
+// worker was stopped first.
+func (w *Worker) deliver(e *v2.Envelope) bool {
+	backoff := w.interval
+	for {
+		err := w.writer.Write(e)
+		if err == nil {
+			return true
+		}
+
+		log.Printf("Drain %s failed to write (retrying in %s): %s", w.drain.Name, backoff, err)
+		if !w.sleep(backoff) {
+			return false
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// inRange reports whether the file can hold envelopes from the drain's
+// source. Every file is in range for a drain without a source.
+func (w *Worker) inRange(file string) bool {
+	if w.drain.SourceID == "" {
+		return true
+	}
+
+	rn, err := hashing.ParseRangeName(file)
+	if err != nil {
+		return false
+	}
+
+	low, high, err := w.hashers.HashRange(rn.HashVersion, w.drain.SourceID)
+	if err != nil {
+		return false
+	}
+
+	return rn.Low <= high && rn.High >= low
+}
@@ -0,0 +1,176 @@
+package drain_test
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/poy/eachers/testhelpers"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/drain/internal/drain"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TW struct {
+	*testing.T
+	mockFileSystem *mockFileSystem
+	mockWriter     *mockWriter
+	checkpoints    *drain.Checkpoints
+	worker         *drain.Worker
+	inRange        string
+	outOfRange     string
+}
+
+func TestWorker(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TW {
+		mockFileSystem := newMockFileSystem()
+		mockWriter := newMockWriter()
+		checkpoints, err := drain.LoadCheckpoints("")
+		Expect(t, err == nil).To(BeTrue())
+
+		drains, err := drain.New(drain.Config{
+			Drains: []drain.DrainConfig{
+				{Name: "some-drain", URL: "syslog://localhost:514", Filter: []byte(`{"source_id": "a"}`)},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		hasher, err := hashing.New(hashing.V1, nil)
+		Expect(t, err == nil).To(BeTrue())
+		hash := hasher.HashString("a")
+
+		inRange := fmt.Sprintf(`{"low":%d,"high":%d,"term":0}`, hash, hash)
+		outOfRange := fmt.Sprintf(`{"low":%d,"high":%d,"term":0}`, hash+1, hash+1)
+		testhelpers.AlwaysReturn(mockFileSystem.ListOutput, []string{outOfRange, inRange}, nil)
+
+		return TW{
+			T:              t,
+			mockFileSystem: mockFileSystem,
+			mockWriter:     mockWriter,
+			checkpoints:    checkpoints,
+			inRange:        inRange,
+			outOfRange:     outOfRange,
+			worker: drain.NewWorker(
+				drains[0],
+				mockFileSystem,
+				mockWriter,
+				checkpoints,
+				hashing.NewHashers(nil),
+				time.Millisecond,
+			),
+		}
+	})
+
+	o.Spec("it writes the matching envelopes of the source's ranges", func(t TW) {
+		testhelpers.AlwaysReturn(t.mockFileSystem.ReaderOutput, reader(t,
+			&v2.Envelope{SourceId: "a", Timestamp: 1},
+			&v2.Envelope{SourceId: "b", Timestamp: 2},
+			&v2.Envelope{SourceId: "a", Timestamp: 3},
+		), nil)
+		testhelpers.AlwaysReturn(t.mockWriter.WriteOutput, nil)
+		t.worker.Start()
+
+		Expect(t, t.mockFileSystem.ReaderInput.Name).To(ViaPolling(
+			Chain(Receive(), Equal(t.inRange)),
+		))
+		Expect(t, t.mockFileSystem.ReaderInput.StartIndex).To(ViaPolling(
+			Chain(Receive(), Equal(uint64(0))),
+		))
+
+		var e *v2.Envelope
+		Expect(t, t.mockWriter.WriteInput.E).To(ViaPolling(
+			Chain(Receive(), Fetch(&e)),
+		))
+		Expect(t, e.Timestamp).To(Equal(int64(1)))
+		Expect(t, t.mockWriter.WriteInput.E).To(ViaPolling(
+			Chain(Receive(), Fetch(&e)),
+		))
+		Expect(t, e.Timestamp).To(Equal(int64(3)))
+
+		Expect(t, func() uint64 {
+			return t.checkpoints.Next("some-drain", t.inRange)
+		}).To(ViaPolling(Equal(uint64(3))))
+		for len(t.mockFileSystem.ReaderInput.Name) > 0 {
+			Expect(t, <-t.mockFileSystem.ReaderInput.Name).To(Equal(t.inRange))
+		}
+	})
+
+	o.Spec("it starts from the checkpoint", func(t TW) {
+		t.checkpoints.Set("some-drain", t.inRange, 5)
+		testhelpers.AlwaysReturn(t.mockFileSystem.ReaderOutput, reader(t), nil)
+		t.worker.Start()
+
+		Expect(t, t.mockFileSystem.ReaderInput.StartIndex).To(ViaPolling(
+			Chain(Receive(), Equal(uint64(5))),
+		))
+	})
+
+	o.Spec("it retries failed writes before moving on", func(t TW) {
+		testhelpers.AlwaysReturn(t.mockFileSystem.ReaderOutput, reader(t,
+			&v2.Envelope{SourceId: "a", Timestamp: 1},
+			&v2.Envelope{SourceId: "a", Timestamp: 2},
+		), nil)
+		t.mockWriter.WriteOutput.Ret0 <- fmt.Errorf("some-error")
+		testhelpers.AlwaysReturn(t.mockWriter.WriteOutput, nil)
+		t.worker.Start()
+
+		var timestamps []int64
+		for i := 0; i < 3; i++ {
+			var e *v2.Envelope
+			Expect(t, t.mockWriter.WriteInput.E).To(ViaPolling(
+				Chain(Receive(), Fetch(&e)),
+			))
+			timestamps = append(timestamps, e.Timestamp)
+		}
+		Expect(t, timestamps).To(Equal([]int64{1, 1, 2}))
+	})
+}
+
+// reader returns the envelopes with their position as the index and then
+// io.EOF.
+func reader(t TW, envelopes ...*v2.Envelope) func() (*v1.ReadData, error) {
+	var (
+		mu sync.Mutex
+		i  int
+	)
+	return func() (*v1.ReadData, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if i >= len(envelopes) {
+			return nil, io.EOF
+		}
+
+		data, err := proto.Marshal(envelopes[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		i++
+
+		return &v1.ReadData{Payload: data, Index: uint64(i - 1)}, nil
+	}
+}
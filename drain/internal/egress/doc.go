@@ -0,0 +1,3 @@
+package egress
+
+//go:generate hel
@@ -0,0 +1,261 @@
+package egress
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/drain/internal/drain"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+)
+
+const (
+	// batchSize and flushInterval bound how long a BatchedReceiver waits
+	// before sending a batch.
+	batchSize     = 100
+	flushInterval = 250 * time.Millisecond
+)
+
+type FileSystem interface {
+	List() (files []string, err error)
+	FilteredReader(name string, startIndex uint64, filter *v1.AnalystFilter) (reader func() (*v1.ReadData, error), cancel func(), err error)
+}
+
+// Server implements the loggregator v2 Egress service. Each subscription
+// follows the ranges of its selectors' sources. The data nodes only send
+// the envelopes that were ingested after the subscription started.
+//
+// Subscriptions with the same shard ID and selectors share their envelopes:
+// each envelope is sent to only one of them. Subscriptions without a shard
+// ID receive every envelope.
+type Server struct {
+	fs       FileSystem
+	hashers  drain.Hashers
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	groups map[string]*group
+}
+
+func New(fs FileSystem, hashers drain.Hashers, interval time.Duration, now func() time.Time) *Server {
+	return &Server{
+		fs:       fs,
+		hashers:  hashers,
+		interval: interval,
+		now:      now,
+		groups:   make(map[string]*group),
+	}
+}
+
+func Start(addr string, s *Server) (actualAddr string, err error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	g := grpc.NewServer()
+	v2.RegisterEgressServer(g, s)
+	go func() {
+		log.Fatal(g.Serve(lis))
+	}()
+
+	return lis.Addr().String(), nil
+}
+
+func (s *Server) Receiver(req *v2.EgressRequest, rx v2.Egress_ReceiverServer) error {
+	g, err := s.join(req.ShardId, req.Selectors)
+	if err != nil {
+		return err
+	}
+	defer s.leave(g)
+
+	for {
+		select {
+		case <-rx.Context().Done():
+			return rx.Context().Err()
+		case e := <-g.envelopes:
+			if err := rx.Send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) BatchedReceiver(req *v2.EgressBatchRequest, rx v2.Egress_BatchedReceiverServer) error {
+	g, err := s.join(req.ShardId, req.Selectors)
+	if err != nil {
+		return err
+	}
+	defer s.leave(g)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var batch []*v2.Envelope
+	for {
+		select {
+		case <-rx.Context().Done():
+			return rx.Context().Err()
+		case e := <-g.envelopes:
+			batch = append(batch, e)
+			if len(batch) < batchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		if err := rx.Send(&v2.EnvelopeBatch{Batch: batch}); err != nil {
+			return err
+		}
+		batch = nil
+	}
+}
+
+type group struct {
+	key       string
+	envelopes chan *v2.Envelope
+	done      chan struct{}
+	workers   []*drain.Worker
+	members   int
+}
+
+func (s *Server) join(shardID string, selectors []*v2.Selector) (*group, error) {
+	if len(selectors) == 0 {
+		return nil, fmt.Errorf("at least one selector is required")
+	}
+
+	var key string
+	if shardID != "" {
+		key = shardID + "/" + proto.CompactTextString(&v2.EgressRequest{Selectors: selectors})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.groups[key]; ok && key != "" {
+		g.members++
+		return g, nil
+	}
+
+	g := s.startGroup(key, selectors)
+	if key != "" {
+		s.groups[key] = g
+	}
+	return g, nil
+}
+
+func (s *Server) leave(g *group) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g.members--
+	if g.members > 0 {
+		return
+	}
+
+	if g.key != "" {
+		delete(s.groups, g.key)
+	}
+	close(g.done)
+	for _, w := range g.workers {
+		w.Stop()
+	}
+}
+
+func (s *Server) startGroup(key string, selectors []*v2.Selector) *group {
+	g := &group{
+		key:       key,
+		envelopes: make(chan *v2.Envelope, batchSize),
+		done:      make(chan struct{}),
+		members:   1,
+	}
+
+	checkpoints, _ := drain.LoadCheckpoints("")
+	start := s.now().UnixNano()
+	writer := &groupWriter{
+		start: start,
+		g:     g,
+	}
+
+	for _, id := range sourceIDs(selectors) {
+		w := drain.NewWorker(
+			drain.Drain{
+				Name:     "egress/" + id,
+				SourceID: id,
+				Filter:   selectorsFor(selectors, id),
+			},
+			&filteredFileSystem{
+				fs:     s.fs,
+				filter: readFilter(id, start),
+			},
+			writer,
+			checkpoints,
+			s.hashers,
+			s.interval,
+		)
+		w.Start()
+		g.workers = append(g.workers, w)
+	}
+
+	return g
+}
+
+// readFilter is the filter sent to the data nodes. It keeps the source's
+// envelopes that were ingested from start on.
+func readFilter(sourceID string, start int64) *v1.AnalystFilter {
+	return &v1.AnalystFilter{
+		SourceId:        sourceID,
+		TimestampSource: v1.TimestampSource_INGEST,
+		TimeRange: &v1.TimeRange{
+			Start: start,
+			End:   math.MaxInt64,
+		},
+	}
+}
+
+// filteredFileSystem reads the ranges with the filter applied by the data
+// nodes. The streams end when the reader fails or after a minute.
+type filteredFileSystem struct {
+	fs     FileSystem
+	filter *v1.AnalystFilter
+}
+
+func (f *filteredFileSystem) List() (files []string, err error) {
+	return f.fs.List()
+}
+
+func (f *filteredFileSystem) Reader(name string, startIndex uint64) (reader func() (*v1.ReadData, error), err error) {
+	reader, _, err = f.fs.FilteredReader(name, startIndex, f.filter)
+	return reader, err
+}
+
+// groupWriter hands envelopes to the group's subscriptions. Envelopes that
+// were ingested before the group started are skipped.
+type groupWriter struct {
+	start int64
+	g     *group
+}
+
+func (w *groupWriter) Write(e *v2.Envelope) error {
+	if ingest.Timestamp(e) < w.start {
+		return nil
+	}
+
+	select {
+	case w.g.envelopes <- e:
+	case <-w.g.done:
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package egress_test
+
+import (
+	"flag"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/poy/eachers/testhelpers"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/drain/internal/egress"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TE struct {
+	*testing.T
+	mockFileSystem *mockFileSystem
+	client         v2.EgressClient
+	cancel         func()
+	ctx            context.Context
+}
+
+func TestEgress(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TE {
+		mockFileSystem := newMockFileSystem()
+		testhelpers.AlwaysReturn(mockFileSystem.ListOutput, []string{`{"low":0,"high":18446744073709551615,"term":0}`}, nil)
+		testhelpers.AlwaysReturn(mockFileSystem.FilteredReaderOutput, reader(t,
+			&v2.Envelope{SourceId: "a", Timestamp: 50, Message: &v2.Envelope_Log{Log: &v2.Log{}}},
+			&v2.Envelope{SourceId: "a", Timestamp: 150, Message: &v2.Envelope_Log{Log: &v2.Log{}}},
+			&v2.Envelope{SourceId: "b", Timestamp: 160, Message: &v2.Envelope_Log{Log: &v2.Log{}}},
+			&v2.Envelope{SourceId: "a", Timestamp: 170, Message: &v2.Envelope_Counter{Counter: &v2.Counter{Name: "c"}}},
+			&v2.Envelope{SourceId: "a", Timestamp: 180, Message: &v2.Envelope_Gauge{Gauge: &v2.Gauge{
+				Metrics: map[string]*v2.GaugeValue{"x": {}, "y": {}},
+			}}},
+		), func() {}, nil)
+
+		s := egress.New(mockFileSystem, hashing.NewHashers(nil), time.Millisecond, func() time.Time {
+			return time.Unix(0, 100)
+		})
+		addr, err := egress.Start("127.0.0.1:0", s)
+		Expect(t, err == nil).To(BeTrue())
+
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		Expect(t, err == nil).To(BeTrue())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		return TE{
+			T:              t,
+			mockFileSystem: mockFileSystem,
+			client:         v2.NewEgressClient(conn),
+			ctx:            ctx,
+			cancel:         cancel,
+		}
+	})
+
+	o.AfterEach(func(t TE) {
+		t.cancel()
+	})
+
+	o.Spec("it has the data nodes skip envelopes ingested before the subscription", func(t TE) {
+		rx, err := t.client.Receiver(t.ctx, &v2.EgressRequest{
+			Selectors: []*v2.Selector{{SourceId: "a"}},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, t.mockFileSystem.FilteredReaderInput.Filter).To(Chain(Receive(), Equal(&v1.AnalystFilter{
+			SourceId:        "a",
+			TimestampSource: v1.TimestampSource_INGEST,
+			TimeRange: &v1.TimeRange{
+				Start: 100,
+				End:   math.MaxInt64,
+			},
+		})))
+	})
+
+	o.Spec("it streams the new envelopes that match the selectors", func(t TE) {
+		rx, err := t.client.Receiver(t.ctx, &v2.EgressRequest{
+			Selectors: []*v2.Selector{
+				{SourceId: "a", Message: &v2.Selector_Log{Log: &v2.LogSelector{}}},
+				{SourceId: "a", Message: &v2.Selector_Gauge{Gauge: &v2.GaugeSelector{Names: []string{"x", "y"}}}},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		e, err := rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.Timestamp).To(Equal(int64(150)))
+
+		e, err = rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, e.Timestamp).To(Equal(int64(180)))
+	})
+
+	o.Spec("it streams batches", func(t TE) {
+		rx, err := t.client.BatchedReceiver(t.ctx, &v2.EgressBatchRequest{
+			Selectors: []*v2.Selector{
+				{Message: &v2.Selector_Counter{Counter: &v2.CounterSelector{Name: "c"}}},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		batch, err := rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, batch.Batch).To(HaveLen(1))
+		Expect(t, batch.Batch[0].GetCounter().GetName()).To(Equal("c"))
+	})
+
+	o.Spec("it requires a selector", func(t TE) {
+		rx, err := t.client.Receiver(t.ctx, &v2.EgressRequest{})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rx.Recv()
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func TestEgressSharedRange(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TE {
+		fs := &rangeFileSystem{
+			t: t,
+			envelopes: []*v2.Envelope{
+				{SourceId: "a", Timestamp: 150, Message: &v2.Envelope_Log{Log: &v2.Log{}}},
+				{SourceId: "b", Timestamp: 160, Message: &v2.Envelope_Log{Log: &v2.Log{}}},
+				{SourceId: "a", Timestamp: 170, Message: &v2.Envelope_Log{Log: &v2.Log{}}},
+			},
+		}
+
+		s := egress.New(fs, hashing.NewHashers(nil), time.Millisecond, func() time.Time {
+			return time.Unix(0, 100)
+		})
+		addr, err := egress.Start("127.0.0.1:0", s)
+		Expect(t, err == nil).To(BeTrue())
+
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		Expect(t, err == nil).To(BeTrue())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		return TE{
+			T:      t,
+			client: v2.NewEgressClient(conn),
+			ctx:    ctx,
+			cancel: cancel,
+		}
+	})
+
+	o.AfterEach(func(t TE) {
+		t.cancel()
+	})
+
+	o.Spec("it streams each envelope once for sources in the same range", func(t TE) {
+		rx, err := t.client.Receiver(t.ctx, &v2.EgressRequest{
+			Selectors: []*v2.Selector{
+				{SourceId: "a"},
+				{SourceId: "b"},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		envelopes := make(chan *v2.Envelope, 10)
+		go func() {
+			for {
+				e, err := rx.Recv()
+				if err != nil {
+					return
+				}
+				envelopes <- e
+			}
+		}()
+
+		var timestamps []int64
+		for i := 0; i < 3; i++ {
+			var e *v2.Envelope
+			Expect(t, envelopes).To(ViaPolling(
+				Chain(Receive(), Fetch(&e)),
+			))
+			timestamps = append(timestamps, e.Timestamp)
+		}
+		Expect(t, timestamps).To(Contain(int64(150), int64(160), int64(170)))
+		Expect(t, envelopes).To(Always(HaveLen(0)))
+	})
+}
+
+// rangeFileSystem has a single range that holds every source. Each reader
+// starts at the given index.
+type rangeFileSystem struct {
+	t         *testing.T
+	envelopes []*v2.Envelope
+}
+
+func (f *rangeFileSystem) List() (files []string, err error) {
+	return []string{`{"low":0,"high":18446744073709551615,"term":0}`}, nil
+}
+
+func (f *rangeFileSystem) FilteredReader(name string, startIndex uint64, filter *v1.AnalystFilter) (reader func() (*v1.ReadData, error), cancel func(), err error) {
+	i := startIndex
+	return func() (*v1.ReadData, error) {
+		if i >= uint64(len(f.envelopes)) {
+			return nil, io.EOF
+		}
+
+		data, err := proto.Marshal(f.envelopes[i])
+		if err != nil {
+			f.t.Fatal(err)
+		}
+		i++
+
+		return &v1.ReadData{Payload: data, Index: i - 1}, nil
+	}, func() {}, nil
+}
+
+func reader(t *testing.T, envelopes ...*v2.Envelope) func() (*v1.ReadData, error) {
+	var (
+		mu sync.Mutex
+		i  int
+	)
+	return func() (*v1.ReadData, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if i >= len(envelopes) {
+			return nil, io.EOF
+		}
+
+		data, err := proto.Marshal(envelopes[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		i++
+
+		return &v1.ReadData{Payload: data, Index: uint64(i - 1)}, nil
+	}
+}
@@ -0,0 +1,53 @@
+// This file was generated by github.com/nelsam/hel.  Do not
+// edit this code by hand unless you *really* know what you're
+// doing.  Expect any changes made manually to be overwritten
+// the next time hel regenerates this file.
+
+package egress_test
+
+import v1 "github.com/poy/loggrebutterfly/api/v1"
+
+type mockFileSystem struct {
+	ListCalled chan bool
+	ListOutput struct {
+		Files chan []string
+		Err   chan error
+	}
+	FilteredReaderCalled chan bool
+	FilteredReaderInput  struct {
+		Name       chan string
+		StartIndex chan uint64
+		Filter     chan *v1.AnalystFilter
+	}
+	FilteredReaderOutput struct {
+		Reader chan func() (*v1.ReadData, error)
+		Cancel chan func()
+		Err    chan error
+	}
+}
+
+func newMockFileSystem() *mockFileSystem {
+	m := &mockFileSystem{}
+	m.ListCalled = make(chan bool, 100)
+	m.ListOutput.Files = make(chan []string, 100)
+	m.ListOutput.Err = make(chan error, 100)
+	m.FilteredReaderCalled = make(chan bool, 100)
+	m.FilteredReaderInput.Name = make(chan string, 100)
+	m.FilteredReaderInput.StartIndex = make(chan uint64, 100)
+	m.FilteredReaderInput.Filter = make(chan *v1.AnalystFilter, 100)
+	m.FilteredReaderOutput.Reader = make(chan func() (*v1.ReadData, error), 100)
+	m.FilteredReaderOutput.Cancel = make(chan func(), 100)
+	m.FilteredReaderOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockFileSystem) List() (files []string, err error) {
+	m.ListCalled <- true
+	return <-m.ListOutput.Files, <-m.ListOutput.Err
+}
+func (m *mockFileSystem) FilteredReader(name string, startIndex uint64, filter *v1.AnalystFilter) (reader func() (*v1.ReadData, error), cancel func(), err error) {
+	m.FilteredReaderCalled <- true
+	m.FilteredReaderInput.Name <- name
+	m.FilteredReaderInput.StartIndex <- startIndex
+	m.FilteredReaderInput.Filter <- filter
+	return <-m.FilteredReaderOutput.Reader, <-m.FilteredReaderOutput.Cancel, <-m.FilteredReaderOutput.Err
+}
@@ -0,0 +1,88 @@
+package egress
+
+import (
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+// selectorFilter keeps the envelopes that match any of the selectors.
+type selectorFilter []*v2.Selector
+
+func (f selectorFilter) Keep(e *v2.Envelope) bool {
+	for _, s := range f {
+		if matches(s, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches follows loggregator's semantics: an empty source ID matches any
+// source, a selector without a message type matches every type, a counter
+// selector without a name matches every counter and a gauge selector
+// matches gauges that have all of its names.
+func matches(s *v2.Selector, e *v2.Envelope) bool {
+	if s.SourceId != "" && s.SourceId != e.SourceId {
+		return false
+	}
+
+	switch m := s.Message.(type) {
+	case nil:
+		return true
+	case *v2.Selector_Log:
+		return e.GetLog() != nil
+	case *v2.Selector_Counter:
+		c := e.GetCounter()
+		return c != nil && (m.Counter.GetName() == "" || m.Counter.GetName() == c.Name)
+	case *v2.Selector_Gauge:
+		g := e.GetGauge()
+		if g == nil {
+			return false
+		}
+		for _, name := range m.Gauge.GetNames() {
+			if _, ok := g.Metrics[name]; !ok {
+				return false
+			}
+		}
+		return true
+	case *v2.Selector_Timer:
+		return e.GetTimer() != nil
+	}
+
+	return false
+}
+
+// sourceIDs returns the source IDs whose ranges have to be followed. An
+// empty source ID means every range.
+func sourceIDs(selectors []*v2.Selector) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, s := range selectors {
+		if s.SourceId == "" {
+			return []string{""}
+		}
+
+		if !seen[s.SourceId] {
+			seen[s.SourceId] = true
+			ids = append(ids, s.SourceId)
+		}
+	}
+	return ids
+}
+
+// selectorsFor returns the selectors a worker for the source ID applies.
+// Workers for different sources can read the same range, each only keeps
+// its own source's envelopes so none are sent twice. The worker for every
+// range applies all of the selectors.
+func selectorsFor(selectors []*v2.Selector, sourceID string) selectorFilter {
+	if sourceID == "" {
+		return selectors
+	}
+
+	var result selectorFilter
+	for _, s := range selectors {
+		if s.SourceId == sourceID {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,125 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/poy/loggrebutterfly/api/v1"
+)
+
+// FileSystem lists the ranges known to the master and reads them from
+// their leader's data node.
+type FileSystem struct {
+	masterClient pb.MasterClient
+
+	mu      sync.Mutex
+	leaders map[string]string
+	clients map[string]pb.DataNodeClient
+}
+
+func New(masterAddr string) *FileSystem {
+	return &FileSystem{
+		masterClient: setupMasterClient(masterAddr),
+		leaders:      make(map[string]string),
+		clients:      make(map[string]pb.DataNodeClient),
+	}
+}
+
+// List fetches the routes from the master. Routes without a leader are
+// left out.
+func (f *FileSystem) List() (files []string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := f.masterClient.Routes(ctx, new(pb.RoutesInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	leaders := make(map[string]string)
+	for _, r := range resp.Routes {
+		if r.Leader == "" {
+			log.Printf("%s buffer does not have leader", r.Name)
+			continue
+		}
+		leaders[r.Name] = r.Leader
+		files = append(files, r.Name)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.leaders = leaders
+
+	return files, nil
+}
+
+// Reader reads the file from its leader. The reader is closed after a
+// minute so the leader is looked up again.
+func (f *FileSystem) Reader(name string, startIndex uint64) (reader func() (*pb.ReadData, error), err error) {
+	reader, _, err = f.FilteredReader(name, startIndex, nil)
+	return reader, err
+}
+
+// FilteredReader is a Reader that only returns the envelopes that match the
+// filter. The filter is applied by the data node. The stream is released
+// when the reader returns an error or cancel is called.
+func (f *FileSystem) FilteredReader(name string, startIndex uint64, filter *pb.AnalystFilter) (reader func() (*pb.ReadData, error), cancel func(), err error) {
+	client, err := f.client(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	rx, err := client.Read(ctx, &pb.ReadInfo{
+		Name:   name,
+		Index:  startIndex,
+		Filter: filter,
+	})
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return func() (*pb.ReadData, error) {
+		data, err := rx.Recv()
+		if err != nil {
+			cancel()
+		}
+		return data, err
+	}, cancel, nil
+}
+
+func (f *FileSystem) client(name string) (pb.DataNodeClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	addr, ok := f.leaders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown file: %s", name)
+	}
+
+	if client, ok := f.clients[addr]; ok {
+		return client, nil
+	}
+
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	f.clients[addr] = pb.NewDataNodeClient(conn)
+
+	return f.clients[addr], nil
+}
+
+func setupMasterClient(addr string) pb.MasterClient {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("unable to connect to master: %s", err)
+	}
+	return pb.NewMasterClient(conn)
+}
@@ -0,0 +1,3 @@
+package firehose
+
+//go:generate hel
@@ -0,0 +1,331 @@
+package firehose
+
+import (
+	"io"
+	"log"
+	"math"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/golang/protobuf/proto"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/envfilter"
+)
+
+type FileSystem interface {
+	List() (files []string, err error)
+	FilteredReader(name string, startIndex uint64, filter *v1.AnalystFilter) (reader func() (*v1.ReadData, error), cancel func(), err error)
+}
+
+// Server implements the Firehose service. A subscription reads every range
+// and the data nodes apply its filter.
+//
+// Subscriptions with the same group and filter form a consumer group. Each
+// range is sent to one member and the ranges are reassigned when members
+// join or leave. The group keeps reading a range from where it was, so a
+// reassigned range continues with the next envelope.
+type Server struct {
+	fs       FileSystem
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	groups map[string]*group
+}
+
+func New(fs FileSystem, interval time.Duration, now func() time.Time) *Server {
+	return &Server{
+		fs:       fs,
+		interval: interval,
+		now:      now,
+		groups:   make(map[string]*group),
+	}
+}
+
+func Start(addr string, s *Server) (actualAddr string, err error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	g := grpc.NewServer()
+	v1.RegisterFirehoseServer(g, s)
+	go func() {
+		log.Fatal(g.Serve(lis))
+	}()
+
+	return lis.Addr().String(), nil
+}
+
+func (s *Server) Subscribe(info *v1.SubscribeInfo, rx v1.Firehose_SubscribeServer) error {
+	if _, err := envfilter.New(info.Filter, envfilter.MatchAnySource()); err != nil {
+		return err
+	}
+
+	g, m := s.join(info)
+	defer s.leave(g, m)
+
+	for {
+		select {
+		case <-rx.Context().Done():
+			return rx.Context().Err()
+		case data := <-m.data:
+			if err := rx.Send(data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) join(info *v1.SubscribeInfo) (*group, *member) {
+	var key string
+	if info.Group != "" {
+		key = info.Group + "/" + proto.CompactTextString(&v1.SubscribeInfo{Filter: info.Filter})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g, ok := s.groups[key]; ok && key != "" {
+		return g, g.join()
+	}
+
+	g := s.newGroup(key, info.Filter)
+	if key != "" {
+		s.groups[key] = g
+	}
+
+	m := g.join()
+	go g.run()
+
+	return g, m
+}
+
+func (s *Server) leave(g *group, m *member) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !g.leave(m) {
+		return
+	}
+
+	if g.key != "" {
+		delete(s.groups, g.key)
+	}
+	close(g.done)
+}
+
+func (s *Server) newGroup(key string, filter *v1.AnalystFilter) *group {
+	return &group{
+		key:       key,
+		fs:        s.fs,
+		filter:    s.readFilter(filter),
+		interval:  s.interval,
+		done:      make(chan struct{}),
+		owners:    make(map[string]*member),
+		following: make(map[string]bool),
+	}
+}
+
+// readFilter is the filter sent to the data nodes. A filter without a time
+// range only keeps the envelopes that are ingested from now on.
+func (s *Server) readFilter(filter *v1.AnalystFilter) *v1.AnalystFilter {
+	if filter.GetTimeRange() != nil {
+		return filter
+	}
+
+	f := new(v1.AnalystFilter)
+	if filter != nil {
+		f = proto.Clone(filter).(*v1.AnalystFilter)
+	}
+	f.TimestampSource = v1.TimestampSource_INGEST
+	f.TimeRange = &v1.TimeRange{
+		Start: s.now().UnixNano(),
+		End:   math.MaxInt64,
+	}
+
+	return f
+}
+
+type member struct {
+	data chan *v1.ReadData
+	done chan struct{}
+}
+
+type group struct {
+	key      string
+	fs       FileSystem
+	filter   *v1.AnalystFilter
+	interval time.Duration
+	done     chan struct{}
+
+	mu        sync.Mutex
+	members   []*member
+	files     []string
+	owners    map[string]*member
+	following map[string]bool
+}
+
+func (g *group) join() *member {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	m := &member{
+		data: make(chan *v1.ReadData),
+		done: make(chan struct{}),
+	}
+	g.members = append(g.members, m)
+	g.rebalance()
+
+	return m
+}
+
+// leave removes the member and reports whether the group is empty.
+func (g *group) leave(m *member) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for i, x := range g.members {
+		if x == m {
+			g.members = append(g.members[:i], g.members[i+1:]...)
+			break
+		}
+	}
+	g.rebalance()
+	close(m.done)
+
+	return len(g.members) == 0
+}
+
+// rebalance assigns the sorted files to the members round-robin. It must be
+// called with the lock held.
+func (g *group) rebalance() {
+	g.owners = make(map[string]*member)
+	if len(g.members) == 0 {
+		return
+	}
+
+	for i, file := range g.files {
+		g.owners[file] = g.members[i%len(g.members)]
+	}
+}
+
+func (g *group) owner(file string) *member {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.owners[file]
+}
+
+// run lists the ranges every interval and follows the new ones.
+func (g *group) run() {
+	for {
+		g.poll()
+		if !g.sleep() {
+			return
+		}
+	}
+}
+
+func (g *group) sleep() bool {
+	select {
+	case <-g.done:
+		return false
+	case <-time.After(g.interval):
+		return true
+	}
+}
+
+func (g *group) poll() {
+	files, err := g.fs.List()
+	if err != nil {
+		log.Printf("Firehose failed to list ranges: %s", err)
+		return
+	}
+	sort.Strings(files)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.files = files
+	g.rebalance()
+
+	for _, file := range files {
+		if g.following[file] {
+			continue
+		}
+		g.following[file] = true
+		go g.follow(file)
+	}
+}
+
+// follow reads the file until it is no longer listed. The reader is
+// reopened from the next index after it ends or fails.
+func (g *group) follow(file string) {
+	var next uint64
+	for g.isCurrent(file) {
+		var err error
+		next, err = g.readFile(file, next)
+		if err != nil && err != io.EOF {
+			log.Printf("Firehose failed to read %s: %s", file, err)
+		}
+
+		if !g.sleep() {
+			return
+		}
+	}
+}
+
+func (g *group) isCurrent(file string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	i := sort.SearchStrings(g.files, file)
+	if i == len(g.files) || g.files[i] != file {
+		delete(g.following, file)
+		return false
+	}
+	return true
+}
+
+func (g *group) readFile(file string, next uint64) (uint64, error) {
+	reader, cancel, err := g.fs.FilteredReader(file, next, g.filter)
+	if err != nil {
+		return next, err
+	}
+	defer cancel()
+
+	for {
+		data, err := reader()
+		if err != nil {
+			return next, err
+		}
+
+		if !g.deliver(file, data) {
+			return next, nil
+		}
+		next = data.Index + 1
+	}
+}
+
+// deliver sends the data to the member that owns the file. If the member
+// leaves first, the data goes to the file's next owner. It returns false
+// if the file has no owner.
+func (g *group) deliver(file string, data *v1.ReadData) bool {
+	for {
+		m := g.owner(file)
+		if m == nil {
+			return false
+		}
+
+		select {
+		case m.data <- data:
+			return true
+		case <-m.done:
+		case <-g.done:
+			return false
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package firehose_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/poy/eachers/testhelpers"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/drain/internal/firehose"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TF struct {
+	*testing.T
+	mockFileSystem *mockFileSystem
+	client         v1.FirehoseClient
+	cancel         func()
+	ctx            context.Context
+}
+
+func TestFirehose(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TF {
+		mockFileSystem := newMockFileSystem()
+		testhelpers.AlwaysReturn(mockFileSystem.FilteredReaderOutput, reader(), func() {}, nil)
+
+		s := firehose.New(mockFileSystem, time.Millisecond, func() time.Time {
+			return time.Unix(0, 100)
+		})
+		addr, err := firehose.Start("127.0.0.1:0", s)
+		Expect(t, err == nil).To(BeTrue())
+
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		Expect(t, err == nil).To(BeTrue())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		return TF{
+			T:              t,
+			mockFileSystem: mockFileSystem,
+			client:         v1.NewFirehoseClient(conn),
+			ctx:            ctx,
+			cancel:         cancel,
+		}
+	})
+
+	o.AfterEach(func(t TF) {
+		t.cancel()
+	})
+
+	o.Spec("it reads every range", func(t TF) {
+		testhelpers.AlwaysReturn(t.mockFileSystem.ListOutput, []string{"b", "a"}, nil)
+
+		rx, err := t.client.Subscribe(t.ctx, &v1.SubscribeInfo{})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+
+		names := make(map[string]bool)
+		for len(names) < 2 {
+			var name string
+			Expect(t, t.mockFileSystem.FilteredReaderInput.Name).To(ViaPolling(
+				Chain(Receive(), Fetch(&name)),
+			))
+			names[name] = true
+		}
+		Expect(t, names).To(Equal(map[string]bool{"a": true, "b": true}))
+	})
+
+	o.Spec("it only reads envelopes ingested after the subscription", func(t TF) {
+		testhelpers.AlwaysReturn(t.mockFileSystem.ListOutput, []string{"a"}, nil)
+
+		rx, err := t.client.Subscribe(t.ctx, &v1.SubscribeInfo{
+			Filter: &v1.AnalystFilter{SourceId: "some-id"},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, t.mockFileSystem.FilteredReaderInput.Filter).To(ViaPolling(
+			Chain(Receive(), Equal(&v1.AnalystFilter{
+				SourceId:        "some-id",
+				TimestampSource: v1.TimestampSource_INGEST,
+				TimeRange: &v1.TimeRange{
+					Start: 100,
+					End:   math.MaxInt64,
+				},
+			})),
+		))
+	})
+
+	o.Spec("it keeps the time range of the filter", func(t TF) {
+		testhelpers.AlwaysReturn(t.mockFileSystem.ListOutput, []string{"a"}, nil)
+		filter := &v1.AnalystFilter{
+			TimeRange: &v1.TimeRange{Start: 1, End: 2},
+		}
+
+		rx, err := t.client.Subscribe(t.ctx, &v1.SubscribeInfo{Filter: filter})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rx.Recv()
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, t.mockFileSystem.FilteredReaderInput.Filter).To(ViaPolling(
+			Chain(Receive(), Equal(filter)),
+		))
+	})
+
+	o.Spec("it shares the ranges between the members of a group", func(t TF) {
+		testhelpers.AlwaysReturn(t.mockFileSystem.ListOutput, []string{"a", "b"}, nil)
+
+		ctxA, cancelA := context.WithCancel(t.ctx)
+		rxA, err := t.client.Subscribe(ctxA, &v1.SubscribeInfo{Group: "some-group"})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rxA.Recv()
+		Expect(t, err == nil).To(BeTrue())
+
+		// B only receives data once a range is assigned to it.
+		rxB, err := t.client.Subscribe(t.ctx, &v1.SubscribeInfo{Group: "some-group"})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rxB.Recv()
+		Expect(t, err == nil).To(BeTrue())
+		cancelA()
+
+		for i := 0; i < 10; i++ {
+			_, err = rxB.Recv()
+			Expect(t, err == nil).To(BeTrue())
+		}
+		Expect(t, t.mockFileSystem.FilteredReaderCalled).To(HaveLen(2))
+	})
+
+	o.Spec("it returns an error for an invalid filter", func(t TF) {
+		rx, err := t.client.Subscribe(t.ctx, &v1.SubscribeInfo{
+			Filter: &v1.AnalystFilter{
+				Envelopes: &v1.AnalystFilter_Log{
+					Log: &v1.LogFilter{
+						Payload: &v1.LogFilter_Regexp{Regexp: "["},
+					},
+				},
+			},
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		_, err = rx.Recv()
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+// reader never ends. Each read returns the next index.
+func reader() func() (*v1.ReadData, error) {
+	var (
+		mu sync.Mutex
+		i  uint64
+	)
+	return func() (*v1.ReadData, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		i++
+		return &v1.ReadData{Payload: []byte("some-data"), Index: i - 1}, nil
+	}
+}
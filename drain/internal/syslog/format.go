@@ -0,0 +1,160 @@
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+// enterpriseID is the private enterprise number used for the structured
+// data IDs. It is the one loggregator uses so existing parsers understand
+// the drained messages.
+const enterpriseID = "47450"
+
+const timestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// Format formats the envelope as an RFC 5424 message. The source ID is the
+// app name and the tags are structured data. Counters and gauges are
+// structured data with an empty message. ERR logs have a severity of error
+// and everything else a severity of info.
+func Format(e *v2.Envelope, hostname string) []byte {
+	severity := 6
+	if e.GetLog().GetType() == v2.Log_ERR {
+		severity = 3
+	}
+
+	timestamp := "-"
+	if e.Timestamp != 0 {
+		timestamp = time.Unix(0, e.Timestamp).UTC().Format(timestampFormat)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s - - ",
+		8+severity,
+		timestamp,
+		headerField(hostname, 255),
+		headerField(e.SourceId, 48),
+	)
+
+	sd := structuredData(e)
+	if sd == "" {
+		sd = "-"
+	}
+	buf.WriteString(sd)
+
+	if payload := bytes.TrimRight(e.GetLog().GetPayload(), "\r\n"); len(payload) > 0 {
+		buf.WriteByte(' ')
+		buf.Write(payload)
+	}
+
+	return buf.Bytes()
+}
+
+func structuredData(e *v2.Envelope) string {
+	var buf bytes.Buffer
+	if len(e.Tags) > 0 {
+		var keys []string
+		for k := range e.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var params [][2]string
+		for _, k := range keys {
+			params = append(params, [2]string{k, tagValue(e.Tags[k])})
+		}
+		writeElement(&buf, "tags", params)
+	}
+
+	switch m := e.Message.(type) {
+	case *v2.Envelope_Counter:
+		writeElement(&buf, "counter", [][2]string{
+			{"name", m.Counter.Name},
+			{"delta", strconv.FormatUint(m.Counter.GetDelta(), 10)},
+			{"total", strconv.FormatUint(m.Counter.GetTotal(), 10)},
+		})
+	case *v2.Envelope_Gauge:
+		var names []string
+		for name := range m.Gauge.Metrics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			v := m.Gauge.Metrics[name]
+			writeElement(&buf, "gauge", [][2]string{
+				{"name", name},
+				{"unit", v.Unit},
+				{"value", strconv.FormatFloat(v.Value, 'g', -1, 64)},
+			})
+		}
+	}
+
+	return buf.String()
+}
+
+func writeElement(buf *bytes.Buffer, id string, params [][2]string) {
+	fmt.Fprintf(buf, "[%s@%s", id, enterpriseID)
+	for _, p := range params {
+		fmt.Fprintf(buf, " %s=\"%s\"", paramName(p[0]), escape(p[1]))
+	}
+	buf.WriteByte(']')
+}
+
+func tagValue(v *v2.Value) string {
+	switch d := v.GetData().(type) {
+	case *v2.Value_Text:
+		return d.Text
+	case *v2.Value_Integer:
+		return strconv.FormatInt(d.Integer, 10)
+	case *v2.Value_Decimal:
+		return strconv.FormatFloat(d.Decimal, 'g', -1, 64)
+	}
+	return ""
+}
+
+// headerField replaces the characters that are not allowed in a header
+// field and truncates it.
+func headerField(s string, max int) string {
+	if s == "" {
+		return "-"
+	}
+
+	b := []byte(s)
+	if len(b) > max {
+		b = b[:max]
+	}
+	for i, c := range b {
+		if c < 33 || c > 126 {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+// paramName replaces the characters that are not allowed in a parameter
+// name and truncates it to 32 characters.
+func paramName(s string) string {
+	b := []byte(headerField(s, 32))
+	for i, c := range b {
+		if c == '=' || c == ']' || c == '"' {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+func escape(s string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' || s[i] == ']' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
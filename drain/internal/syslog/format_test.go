@@ -0,0 +1,57 @@
+package syslog_test
+
+import (
+	"testing"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/drain/internal/syslog"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	timestamp := time.Date(2017, time.March, 1, 22, 14, 15, 3000, time.UTC).UnixNano()
+
+	o.Spec("it formats logs", func(t *testing.T) {
+		msg := syslog.Format(&v2.Envelope{
+			Timestamp: timestamp,
+			SourceId:  "some id",
+			Tags: map[string]*v2.Value{
+				"b": {Data: &v2.Value_Integer{Integer: 1}},
+				"a": {Data: &v2.Value_Text{Text: `x"]\`}},
+			},
+			Message: &v2.Envelope_Log{Log: &v2.Log{Payload: []byte("some-message\n"), Type: v2.Log_ERR}},
+		}, "some-host")
+
+		Expect(t, string(msg)).To(Equal(
+			`<11>1 2017-03-01T22:14:15.000003Z some-host some_id - - [tags@47450 a="x\"\]\\" b="1"] some-message`,
+		))
+	})
+
+	o.Spec("it formats counters and gauges as structured data", func(t *testing.T) {
+		msg := syslog.Format(&v2.Envelope{
+			Timestamp: timestamp,
+			SourceId:  "some-id",
+			Message:   &v2.Envelope_Counter{Counter: &v2.Counter{Name: "requests", Value: &v2.Counter_Total{Total: 10}}},
+		}, "")
+		Expect(t, string(msg)).To(Equal(
+			`<14>1 2017-03-01T22:14:15.000003Z - some-id - - [counter@47450 name="requests" delta="0" total="10"]`,
+		))
+
+		msg = syslog.Format(&v2.Envelope{
+			SourceId: "some-id",
+			Message: &v2.Envelope_Gauge{Gauge: &v2.Gauge{Metrics: map[string]*v2.GaugeValue{
+				"memory": {Unit: "bytes", Value: 1.5},
+			}}},
+		}, "")
+		Expect(t, string(msg)).To(Equal(
+			`<14>1 - - some-id - - [gauge@47450 name="memory" unit="bytes" value="1.5"]`,
+		))
+	})
+}
@@ -0,0 +1,82 @@
+package syslog
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+const (
+	dialTimeout  = 5 * time.Second
+	writeTimeout = 5 * time.Second
+)
+
+// Writer sends envelopes to a syslog destination over TCP. Messages are
+// framed with octet counting (RFC 6587). The connection is opened on the
+// first write and after a failed write.
+type Writer struct {
+	addr      string
+	hostname  string
+	tlsConfig *tls.Config
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewWriter returns a Writer for a syslog:// or syslog-tls:// URL.
+func NewWriter(u *url.URL, hostname string, skipCertVerify bool) (*Writer, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing host: %s", u)
+	}
+
+	w := &Writer{
+		addr:     u.Host,
+		hostname: hostname,
+	}
+
+	switch u.Scheme {
+	case "syslog":
+	case "syslog-tls":
+		w.tlsConfig = &tls.Config{InsecureSkipVerify: skipCertVerify}
+	default:
+		return nil, fmt.Errorf("unsupported scheme: %s", u.Scheme)
+	}
+
+	return w, nil
+}
+
+func (w *Writer) Write(e *v2.Envelope) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.conn == nil {
+		conn, err := w.dial()
+		if err != nil {
+			return err
+		}
+		w.conn = conn
+	}
+
+	msg := Format(e, w.hostname)
+	w.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := fmt.Fprintf(w.conn, "%d %s", len(msg), msg); err != nil {
+		w.conn.Close()
+		w.conn = nil
+		return err
+	}
+
+	return nil
+}
+
+func (w *Writer) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	if w.tlsConfig != nil {
+		return tls.DialWithDialer(dialer, "tcp", w.addr, w.tlsConfig)
+	}
+	return dialer.Dial("tcp", w.addr)
+}
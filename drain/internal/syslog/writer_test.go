@@ -0,0 +1,112 @@
+package syslog_test
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/drain/internal/syslog"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+type TW struct {
+	*testing.T
+	lis      net.Listener
+	messages chan string
+	writer   *syslog.Writer
+}
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TW {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(t, err == nil).To(BeTrue())
+
+		messages := make(chan string, 100)
+		go acceptMessages(lis, messages)
+
+		writer, err := syslog.NewWriter(&url.URL{Scheme: "syslog", Host: lis.Addr().String()}, "some-host", false)
+		Expect(t, err == nil).To(BeTrue())
+
+		return TW{
+			T:        t,
+			lis:      lis,
+			messages: messages,
+			writer:   writer,
+		}
+	})
+
+	o.AfterEach(func(t TW) {
+		t.lis.Close()
+	})
+
+	o.Spec("it writes octet counted messages", func(t TW) {
+		for _, payload := range []string{"a", "b"} {
+			err := t.writer.Write(&v2.Envelope{
+				SourceId: "some-id",
+				Message:  &v2.Envelope_Log{Log: &v2.Log{Payload: []byte(payload)}},
+			})
+			Expect(t, err == nil).To(BeTrue())
+		}
+
+		Expect(t, t.messages).To(ViaPolling(
+			Chain(Receive(), Equal("<14>1 - some-host some-id - - - a")),
+		))
+		Expect(t, t.messages).To(ViaPolling(
+			Chain(Receive(), Equal("<14>1 - some-host some-id - - - b")),
+		))
+	})
+
+	o.Spec("it returns an error when the destination is down", func(t TW) {
+		t.lis.Close()
+
+		err := t.writer.Write(&v2.Envelope{SourceId: "some-id"})
+		Expect(t, err == nil).To(BeFalse())
+	})
+
+	o.Spec("it rejects unsupported schemes", func(t TW) {
+		_, err := syslog.NewWriter(&url.URL{Scheme: "https", Host: "siem"}, "", false)
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func acceptMessages(lis net.Listener, messages chan<- string) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close()
+			r := bufio.NewReader(conn)
+			for {
+				prefix, err := r.ReadString(' ')
+				if err != nil {
+					return
+				}
+
+				n, err := strconv.Atoi(strings.TrimSpace(prefix))
+				if err != nil {
+					return
+				}
+
+				msg := make([]byte, n)
+				if _, err := io.ReadFull(r, msg); err != nil {
+					return
+				}
+				messages <- string(msg)
+			}
+		}()
+	}
+}
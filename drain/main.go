@@ -0,0 +1,80 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/poy/loggrebutterfly/drain/internal/config"
+	"github.com/poy/loggrebutterfly/drain/internal/drain"
+	"github.com/poy/loggrebutterfly/drain/internal/egress"
+	"github.com/poy/loggrebutterfly/drain/internal/filesystem"
+	"github.com/poy/loggrebutterfly/drain/internal/firehose"
+	"github.com/poy/loggrebutterfly/drain/internal/syslog"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+
+	_ "net/http/pprof"
+)
+
+func main() {
+	log.Print("Starting drain...")
+	defer log.Print("Drain is closing.")
+
+	conf := config.Load()
+
+	var drains []drain.Drain
+	if conf.DrainsConfig != "" {
+		var err error
+		drains, err = drain.Load(conf.DrainsConfig)
+		if err != nil {
+			log.Fatalf("Invalid DRAINS_CONFIG: %s", err)
+		}
+	}
+
+	checkpoints, err := drain.LoadCheckpoints(conf.CheckpointFile)
+	if err != nil {
+		log.Fatalf("Failed to load checkpoints: %s", err)
+	}
+
+	fs := filesystem.New(conf.MasterAddr)
+	hashers := hashing.NewHashers(conf.HotSources)
+
+	if conf.EgressAddr != "" {
+		s := egress.New(fs, hashers, conf.PollInterval, time.Now)
+		addr, err := egress.Start(conf.EgressAddr, s)
+		if err != nil {
+			log.Fatalf("Failed to start egress server: %s", err)
+		}
+		log.Printf("Started egress server on %s.", addr)
+	}
+
+	if conf.FirehoseAddr != "" {
+		s := firehose.New(fs, conf.PollInterval, time.Now)
+		addr, err := firehose.Start(conf.FirehoseAddr, s)
+		if err != nil {
+			log.Fatalf("Failed to start firehose server: %s", err)
+		}
+		log.Printf("Started firehose server on %s.", addr)
+	}
+
+	for _, d := range drains {
+		writer, err := syslog.NewWriter(d.URL, conf.Hostname, conf.SkipCertVerify)
+		if err != nil {
+			log.Fatalf("Invalid URL for drain %s: %s", d.Name, err)
+		}
+
+		log.Printf("Starting drain %s to %s...", d.Name, d.URL.Host)
+		drain.NewWorker(d, fs, writer, checkpoints, hashers, conf.PollInterval).Start()
+	}
+
+	go func() {
+		for range time.Tick(conf.CheckpointInterval) {
+			if err := checkpoints.Save(); err != nil {
+				log.Printf("Failed to save checkpoints: %s", err)
+			}
+		}
+	}()
+
+	log.Printf("Starting pprof on %s", conf.PprofAddr)
+	log.Println(http.ListenAndServe(conf.PprofAddr, nil))
+}
@@ -20,6 +20,8 @@ import (
 	pb "github.com/poy/loggrebutterfly/api/v1"
 	"github.com/poy/loggrebutterfly/client"
 	"github.com/poy/loggrebutterfly/internal/end2end"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+	"github.com/poy/loggrebutterfly/internal/sequence"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
@@ -98,11 +100,15 @@ func TestMaster(t *testing.T) {
 			Matcher:  BeTrue(),
 		})
 
-		c := client.New(fmt.Sprintf("127.0.0.1:%d", masterPort))
+		c, err := client.New(fmt.Sprintf("127.0.0.1:%d", masterPort))
+		Expect(t, err == nil).To(BeTrue())
 
 		e := &v2.Envelope{
 			SourceId:  "some-id",
 			Timestamp: 99,
+			Message: &v2.Envelope_Log{
+				Log: &v2.Log{Payload: []byte("some-log")},
+			},
 		}
 
 		reader, err := c.ReadFrom("some-id")
@@ -122,7 +128,10 @@ func TestMaster(t *testing.T) {
 			Duration: 3 * time.Second,
 			Matcher:  BeTrue(),
 		})
-		Expect(t, rxEnvelope.Envelope).To(Equal(e))
+		Expect(t, rxEnvelope.Envelope.SourceId).To(Equal(e.SourceId))
+		Expect(t, rxEnvelope.Envelope.Timestamp).To(Equal(e.Timestamp))
+		Expect(t, rxEnvelope.Envelope.Tags).To(HaveKey(sequence.SequenceTag))
+		Expect(t, rxEnvelope.Envelope.Tags).To(HaveKey(ingest.TimestampTag))
 		Expect(t, rxEnvelope.Filename).To(Not(HaveLen(0)))
 
 		analyst := fetchAnalystClient(analystPorts[0])
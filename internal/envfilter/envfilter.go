@@ -0,0 +1,223 @@
+package envfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+)
+
+// Filter matches envelopes against an AnalystFilter. It is shared by the
+// analyst's queries and the data node's ingest pipeline so both agree on
+// what a filter matches.
+type Filter struct {
+	filter    *v1.AnalystFilter
+	regex     *regexp.Regexp
+	anySource bool
+}
+
+type Option func(*Filter)
+
+// MatchAnySource makes an empty source_id match every source. Without it
+// the source_id has to match the envelope's exactly, as the analyst's
+// queries expect.
+func MatchAnySource() Option {
+	return func(f *Filter) {
+		f.anySource = true
+	}
+}
+
+// New validates the filter and returns a Filter for it. With
+// MatchAnySource a nil filter matches every envelope.
+func New(filter *v1.AnalystFilter, opts ...Option) (*Filter, error) {
+	f := &Filter{
+		filter: filter,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if err := f.validateFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Keep reports whether the envelope matches the filter.
+func (f *Filter) Keep(e *loggregator.Envelope) bool {
+	return f.filterViaSourceID(f.filter, e) &&
+		f.filterViaTimestamp(f.filter, e) &&
+		f.filterViaCounter(f.filter, e) &&
+		f.filterViaLog(f.filter, e) &&
+		f.filterViaGauge(f.filter, e) &&
+		f.filterViaTags(f.filter, e)
+}
+
+func (f *Filter) validateFilter(filter *v1.AnalystFilter) error {
+	var r *regexp.Regexp
+	if pattern := filter.GetLog().GetRegexp(); pattern != "" {
+		var err error
+		r, err = regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+		f.regex = r
+		return nil
+	}
+
+	if g := filter.GetGauge(); g != nil {
+		if g.Name == "" {
+			return nil
+		}
+
+		for n, _ := range g.GetFilter() {
+			if n == g.Name {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Filter map must include name")
+	}
+
+	return nil
+}
+
+func (f *Filter) filterViaSourceID(filter *v1.AnalystFilter, e *loggregator.Envelope) bool {
+	if f.anySource && filter.GetSourceId() == "" {
+		return true
+	}
+
+	return filter.GetSourceId() == e.GetSourceId()
+}
+
+func (f *Filter) filterViaTimestamp(filter *v1.AnalystFilter, e *loggregator.Envelope) bool {
+	if filter.GetTimeRange() == nil {
+		return true
+	}
+
+	t := Timestamp(filter, e)
+	return t >= filter.GetTimeRange().GetStart() &&
+		t < filter.GetTimeRange().GetEnd()
+}
+
+// Timestamp returns the timestamp of the envelope that the filter's
+// timestamp_source selects.
+func Timestamp(filter *v1.AnalystFilter, e *loggregator.Envelope) int64 {
+	if filter.GetTimestampSource() == v1.TimestampSource_INGEST {
+		return ingest.Timestamp(e)
+	}
+
+	return e.Timestamp
+}
+
+func (f *Filter) filterViaLog(filter *v1.AnalystFilter, e *loggregator.Envelope) bool {
+	if filter.GetLog() == nil {
+		return true
+	}
+
+	if e.GetLog() == nil {
+		return false
+	}
+
+	envPayload := e.GetLog().GetPayload()
+
+	switch filter.GetLog().GetPayload().(type) {
+	case *v1.LogFilter_Match:
+		payload := filter.GetLog().GetMatch()
+		if payload == nil {
+			return true
+		}
+
+		if len(payload) != len(envPayload) {
+			return false
+		}
+
+		for i := range payload {
+			if payload[i] != envPayload[i] {
+				return false
+			}
+		}
+
+		return true
+	case *v1.LogFilter_Regexp:
+		pattern := filter.GetLog().GetRegexp()
+		if pattern == "" {
+			return true
+		}
+
+		return f.regex.Match(envPayload)
+	default:
+		return true
+	}
+}
+
+func (f *Filter) filterViaCounter(filter *v1.AnalystFilter, e *loggregator.Envelope) bool {
+	if filter.GetCounter() == nil {
+		return true
+	}
+
+	if e.GetCounter() == nil {
+		return false
+	}
+
+	filterName := filter.GetCounter().GetName()
+	if filterName == "" {
+		return true
+	}
+
+	return filterName == e.GetCounter().GetName()
+}
+
+func (f *Filter) filterViaGauge(filter *v1.AnalystFilter, e *loggregator.Envelope) bool {
+	if filter.GetGauge() == nil {
+		return true
+	}
+
+	if e.GetGauge() == nil {
+		return false
+	}
+
+	for name, value := range filter.GetGauge().GetFilter() {
+		if !f.containsKeyValue(name, value, e.GetGauge().GetMetrics()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *Filter) containsKeyValue(name string, value *v1.GaugeFilterValue, m map[string]*loggregator.GaugeValue) bool {
+	for n, v := range m {
+		if name == n && (value == nil || value.GetValue() == v.GetValue()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Filter) filterViaTags(filter *v1.AnalystFilter, e *loggregator.Envelope) bool {
+	for name, value := range filter.GetTags() {
+		v, ok := e.GetTags()[name]
+		if !ok || tagString(v) != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func tagString(v *loggregator.Value) string {
+	switch d := v.GetData().(type) {
+	case *loggregator.Value_Text:
+		return d.Text
+	case *loggregator.Value_Integer:
+		return strconv.FormatInt(d.Integer, 10)
+	case *loggregator.Value_Decimal:
+		return strconv.FormatFloat(d.Decimal, 'g', -1, 64)
+	}
+	return ""
+}
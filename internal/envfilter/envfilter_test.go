@@ -0,0 +1,102 @@
+package envfilter_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	loggregator "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	v1 "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/internal/envfilter"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("a nil filter keeps every envelope of any source", func(t *testing.T) {
+		f, err := envfilter.New(nil, envfilter.MatchAnySource())
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, f.Keep(&loggregator.Envelope{SourceId: "some-id"})).To(BeTrue())
+	})
+
+	o.Spec("the source ID has to match exactly", func(t *testing.T) {
+		f, err := envfilter.New(&v1.AnalystFilter{SourceId: "some-id"})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, f.Keep(&loggregator.Envelope{SourceId: "some-id"})).To(BeTrue())
+		Expect(t, f.Keep(&loggregator.Envelope{SourceId: "other-id"})).To(BeFalse())
+
+		f, err = envfilter.New(&v1.AnalystFilter{})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, f.Keep(&loggregator.Envelope{SourceId: "some-id"})).To(BeFalse())
+	})
+
+	o.Spec("an empty source ID matches every source with MatchAnySource", func(t *testing.T) {
+		f, err := envfilter.New(&v1.AnalystFilter{
+			Envelopes: &v1.AnalystFilter_Log{
+				Log: &v1.LogFilter{
+					Payload: &v1.LogFilter_Regexp{Regexp: "secret"},
+				},
+			},
+		}, envfilter.MatchAnySource())
+		Expect(t, err == nil).To(BeTrue())
+
+		e := &loggregator.Envelope{
+			SourceId: "some-id",
+			Message: &loggregator.Envelope_Log{
+				Log: &loggregator.Log{Payload: []byte("a secret")},
+			},
+		}
+		Expect(t, f.Keep(e)).To(BeTrue())
+
+		e.GetLog().Payload = []byte("public")
+		Expect(t, f.Keep(e)).To(BeFalse())
+	})
+
+	o.Spec("it keeps envelopes with every tag", func(t *testing.T) {
+		f, err := envfilter.New(&v1.AnalystFilter{
+			Tags: map[string]string{"deployment": "cf", "index": "1"},
+		}, envfilter.MatchAnySource())
+		Expect(t, err == nil).To(BeTrue())
+
+		e := &loggregator.Envelope{
+			Tags: map[string]*loggregator.Value{
+				"deployment": {Data: &loggregator.Value_Text{Text: "cf"}},
+				"index":      {Data: &loggregator.Value_Integer{Integer: 1}},
+			},
+		}
+		Expect(t, f.Keep(e)).To(BeTrue())
+
+		e.Tags["index"] = &loggregator.Value{Data: &loggregator.Value_Integer{Integer: 2}}
+		Expect(t, f.Keep(e)).To(BeFalse())
+
+		delete(e.Tags, "index")
+		Expect(t, f.Keep(e)).To(BeFalse())
+	})
+
+	o.Spec("it returns an error for an invalid regexp", func(t *testing.T) {
+		_, err := envfilter.New(&v1.AnalystFilter{
+			Envelopes: &v1.AnalystFilter_Log{
+				Log: &v1.LogFilter{
+					Payload: &v1.LogFilter_Regexp{Regexp: "["},
+				},
+			},
+		})
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
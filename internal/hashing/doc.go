@@ -0,0 +1,15 @@
+// Package hashing is the routing hash shared by the client, data node,
+// master and analyst.
+//
+// Every range records the hash scheme version it was created with (see
+// RangeName). Ranges without a version predate versioning and use V1.
+//
+// To migrate to a new version:
+//  1. Set HASH_VERSION on the master. The filler only sees ranges of the
+//     new version, so it creates a new set of ranges covering the hash
+//     space.
+//  2. Set HASH_VERSION on the data nodes and clients. Writes now only go
+//     to ranges of the new version.
+//  3. The analyst hashes each range with its own version, so queries
+//     cover both the old and the new ranges.
+package hashing
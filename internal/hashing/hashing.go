@@ -0,0 +1,157 @@
+package hashing
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+// V1 hashes the source ID with FNV-64a. Hot sources keep the top 8 bits of
+// the source ID's hash and take the remaining bits from their secondary key.
+const V1 uint64 = 1
+
+// CurrentVersion is the hash scheme used for new ranges.
+const CurrentVersion = V1
+
+type scheme struct {
+	hash       func(s string) uint64
+	spreadMask uint64
+}
+
+var schemes = map[uint64]scheme{
+	V1: {hash: fnv64a, spreadMask: 1<<56 - 1},
+}
+
+// Hasher routes envelopes with a single hash scheme. Writers and readers
+// must agree on the version and the hot sources.
+type Hasher struct {
+	version    uint64
+	scheme     scheme
+	hotSources map[string]string
+}
+
+// New returns a Hasher for the given hash scheme version. hotSources maps a
+// source ID to the tag that is used as a secondary routing key for that
+// source.
+func New(version uint64, hotSources map[string]string) (*Hasher, error) {
+	s, ok := schemes[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown hash version: %d", version)
+	}
+
+	return &Hasher{
+		version:    version,
+		scheme:     s,
+		hotSources: hotSources,
+	}, nil
+}
+
+func (h *Hasher) Version() uint64 {
+	return h.version
+}
+
+func (h *Hasher) HashString(s string) (hash uint64) {
+	return h.scheme.hash(s)
+}
+
+// Hash takes a marshalled envelope and returns its routing hash.
+func (h *Hasher) Hash(data []byte) (hash uint64, err error) {
+	var e v2.Envelope
+	if err := proto.Unmarshal(data, &e); err != nil {
+		return 0, err
+	}
+
+	return h.HashEnvelope(&e), nil
+}
+
+func (h *Hasher) HashEnvelope(e *v2.Envelope) (hash uint64) {
+	hash = h.scheme.hash(e.SourceId)
+
+	tag, ok := h.hotSources[e.SourceId]
+	if !ok {
+		return hash
+	}
+
+	key := TagKey(e.Tags[tag])
+	return hash&^h.scheme.spreadMask | h.scheme.hash(key)&h.scheme.spreadMask
+}
+
+// TagKey returns the secondary routing key of a tag value. It only depends
+// on the typed value so that it does not change with the protobuf version.
+func TagKey(v *v2.Value) string {
+	switch d := v.GetData().(type) {
+	case *v2.Value_Text:
+		return d.Text
+	case *v2.Value_Integer:
+		return strconv.FormatInt(d.Integer, 10)
+	case *v2.Value_Decimal:
+		return strconv.FormatFloat(d.Decimal, 'g', -1, 64)
+	}
+	return ""
+}
+
+// HashRange returns the hashes a source ID can be written to. A hot source
+// is spread across a block of the hash space, every other source has a
+// single hash.
+func (h *Hasher) HashRange(sourceID string) (low, high uint64) {
+	hash := h.scheme.hash(sourceID)
+	if _, ok := h.hotSources[sourceID]; !ok {
+		return hash, hash
+	}
+
+	return hash &^ h.scheme.spreadMask, hash | h.scheme.spreadMask
+}
+
+// Hashers holds a Hasher for every known version. It is used by readers
+// that have to look at ranges written with different hash schemes.
+type Hashers struct {
+	hashers map[uint64]*Hasher
+}
+
+func NewHashers(hotSources map[string]string) *Hashers {
+	hashers := make(map[uint64]*Hasher)
+	for version := range schemes {
+		hashers[version], _ = New(version, hotSources)
+	}
+
+	return &Hashers{
+		hashers: hashers,
+	}
+}
+
+// HashRange returns the hashes a source ID can be written to with the given
+// hash scheme version.
+func (h *Hashers) HashRange(version uint64, sourceID string) (low, high uint64, err error) {
+	hasher, ok := h.hashers[version]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown hash version: %d", version)
+	}
+
+	low, high = hasher.HashRange(sourceID)
+	return low, high, nil
+}
+
+func fnv64a(s string) uint64 {
+	f := fnv.New64a()
+	f.Write([]byte(s))
+
+	return f.Sum64()
+}
+
+// ParseHotSources parses a list of source_id:tag pairs.
+func ParseHotSources(list []string) (map[string]string, error) {
+	m := make(map[string]string)
+	for _, s := range list {
+		parts := strings.SplitN(s, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid hot source (expected source_id:tag): %s", s)
+		}
+		m[parts[0]] = parts[1]
+	}
+
+	return m, nil
+}
@@ -0,0 +1,174 @@
+package hashing_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+
+	"github.com/poy/loggrebutterfly/internal/hashing"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TH struct {
+	*testing.T
+	h *hashing.Hasher
+}
+
+func TestHasher(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TH {
+		h, err := hashing.New(hashing.V1, map[string]string{"hot-id": "instance_id"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return TH{
+			T: t,
+			h: h,
+		}
+	})
+
+	o.Spec("returns the same hash for an envelope", func(t TH) {
+		dataA := marshal(t, &v2.Envelope{SourceId: "some-id"})
+		dataB := marshal(t, &v2.Envelope{SourceId: "some-id"})
+		dataC := marshal(t, &v2.Envelope{SourceId: "some-other-id"})
+
+		hashA, err := t.h.Hash(dataA)
+		Expect(t, err == nil).To(BeTrue())
+
+		hashB, err := t.h.Hash(dataB)
+		Expect(t, err == nil).To(BeTrue())
+
+		hashC, err := t.h.Hash(dataC)
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, hashA).To(Equal(hashB))
+		Expect(t, hashA).To(Not(Equal(hashC)))
+		Expect(t, hashA).To(Equal(t.h.HashString("some-id")))
+	})
+
+	o.Spec("returns an error for a non envelope", func(t TH) {
+		_, err := t.h.Hash([]byte("not envelope"))
+		Expect(t, err == nil).To(BeFalse())
+	})
+
+	o.Spec("spreads a hot source by its secondary key", func(t TH) {
+		hashA := t.h.HashEnvelope(&v2.Envelope{
+			SourceId: "hot-id",
+			Tags: map[string]*v2.Value{
+				"instance_id": {Data: &v2.Value_Text{Text: "0"}},
+			},
+		})
+		hashB := t.h.HashEnvelope(&v2.Envelope{
+			SourceId: "hot-id",
+			Tags: map[string]*v2.Value{
+				"instance_id": {Data: &v2.Value_Text{Text: "1"}},
+			},
+		})
+		low, high := t.h.HashRange("hot-id")
+
+		Expect(t, hashA).To(Not(Equal(hashB)))
+		Expect(t, low <= hashA && hashA <= high).To(BeTrue())
+		Expect(t, low <= hashB && hashB <= high).To(BeTrue())
+	})
+
+	o.Spec("uses the typed value of the secondary key", func(t TH) {
+		hashA := t.h.HashEnvelope(&v2.Envelope{
+			SourceId: "hot-id",
+			Tags: map[string]*v2.Value{
+				"instance_id": {Data: &v2.Value_Text{Text: "7"}},
+			},
+		})
+		hashB := t.h.HashEnvelope(&v2.Envelope{
+			SourceId: "hot-id",
+			Tags: map[string]*v2.Value{
+				"instance_id": {Data: &v2.Value_Integer{Integer: 7}},
+			},
+		})
+
+		Expect(t, hashA).To(Equal(hashB))
+		Expect(t, hashing.TagKey(&v2.Value{Data: &v2.Value_Decimal{Decimal: 1.5}})).To(Equal("1.5"))
+		Expect(t, hashing.TagKey(nil)).To(Equal(""))
+	})
+
+	o.Spec("returns a single hash for a regular source", func(t TH) {
+		low, high := t.h.HashRange("some-id")
+
+		Expect(t, low).To(Equal(t.h.HashString("some-id")))
+		Expect(t, high).To(Equal(low))
+	})
+
+	o.Spec("returns an error for an unknown version", func(t TH) {
+		_, err := hashing.New(99, nil)
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func TestHashers(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) (*testing.T, *hashing.Hashers) {
+		return t, hashing.NewHashers(map[string]string{"hot-id": "instance_id"})
+	})
+
+	o.Spec("it uses the hasher for the version", func(t *testing.T, h *hashing.Hashers) {
+		expected, _ := hashing.New(hashing.V1, map[string]string{"hot-id": "instance_id"})
+		expectedLow, expectedHigh := expected.HashRange("hot-id")
+
+		low, high, err := h.HashRange(hashing.V1, "hot-id")
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, low).To(Equal(expectedLow))
+		Expect(t, high).To(Equal(expectedHigh))
+	})
+
+	o.Spec("it returns an error for an unknown version", func(t *testing.T, h *hashing.Hashers) {
+		_, _, err := h.HashRange(99, "some-id")
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
+
+func marshal(t TH, e *v2.Envelope) []byte {
+	data, err := proto.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestParseHotSources(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it parses source_id:tag pairs", func(t *testing.T) {
+		m, err := hashing.ParseHotSources([]string{"a:instance_id", "b:c:d"})
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, m).To(Equal(map[string]string{"a": "instance_id", "b": "c:d"}))
+	})
+
+	o.Spec("it returns an error for an invalid pair", func(t *testing.T) {
+		_, err := hashing.ParseHotSources([]string{"a"})
+		Expect(t, err == nil).To(BeFalse())
+	})
+}
@@ -0,0 +1,60 @@
+package hashing
+
+import (
+	"encoding/json"
+
+	"github.com/poy/petasos/router"
+)
+
+// RangeName is the metadata stored in a range's file name. It extends
+// petasos' RangeName with the hash scheme that was used to route into the
+// range. Ranges created before versioning have no version and use V1.
+type RangeName struct {
+	router.RangeName
+	HashVersion uint64 `json:"hash_version,omitempty"`
+}
+
+func ParseRangeName(file string) (RangeName, error) {
+	var rn RangeName
+	if err := json.Unmarshal([]byte(file), &rn); err != nil {
+		return RangeName{}, err
+	}
+
+	if rn.HashVersion == 0 {
+		rn.HashVersion = V1
+	}
+
+	return rn, nil
+}
+
+// StampVersion records the hash scheme version in a file name. Names that
+// are not range names are returned unchanged.
+func StampVersion(file string, version uint64) string {
+	rn, err := ParseRangeName(file)
+	if err != nil {
+		return file
+	}
+	rn.HashVersion = version
+
+	data, err := json.Marshal(rn)
+	if err != nil {
+		return file
+	}
+
+	return string(data)
+}
+
+// FilterVersion removes the range names that were created with a different
+// hash scheme version. Names that are not range names are kept.
+func FilterVersion(files []string, version uint64) []string {
+	var result []string
+	for _, file := range files {
+		rn, err := ParseRangeName(file)
+		if err == nil && rn.HashVersion != version {
+			continue
+		}
+		result = append(result, file)
+	}
+
+	return result
+}
@@ -0,0 +1,54 @@
+package hashing_test
+
+import (
+	"testing"
+
+	"github.com/poy/loggrebutterfly/internal/hashing"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestRangeName(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it defaults unversioned ranges to V1", func(t *testing.T) {
+		rn, err := hashing.ParseRangeName(`{"low":1,"high":2,"term":3}`)
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, rn.Low).To(Equal(uint64(1)))
+		Expect(t, rn.High).To(Equal(uint64(2)))
+		Expect(t, rn.Term).To(Equal(uint64(3)))
+		Expect(t, rn.HashVersion).To(Equal(hashing.V1))
+	})
+
+	o.Spec("it stamps the version into a range name", func(t *testing.T) {
+		file := hashing.StampVersion(`{"low":1,"high":2,"term":3}`, 2)
+
+		rn, err := hashing.ParseRangeName(file)
+		Expect(t, err == nil).To(BeTrue())
+		Expect(t, rn.Low).To(Equal(uint64(1)))
+		Expect(t, rn.HashVersion).To(Equal(uint64(2)))
+	})
+
+	o.Spec("it leaves other names alone", func(t *testing.T) {
+		Expect(t, hashing.StampVersion("some-file", 2)).To(Equal("some-file"))
+	})
+
+	o.Spec("it filters ranges from other versions", func(t *testing.T) {
+		files := hashing.FilterVersion([]string{
+			"some-file",
+			`{"low":0,"high":9}`,
+			`{"low":0,"high":9,"hash_version":1}`,
+			`{"low":0,"high":9,"hash_version":2}`,
+		}, hashing.V1)
+
+		Expect(t, files).To(HaveLen(3))
+		Expect(t, files).To(Contain(
+			"some-file",
+			`{"low":0,"high":9}`,
+			`{"low":0,"high":9,"hash_version":1}`,
+		))
+	})
+}
@@ -0,0 +1,171 @@
+package hlc
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+const (
+	// WallTag is the physical part (in nanoseconds) of the hybrid logical
+	// clock value.
+	WallTag = "hlc_wall"
+
+	// LogicalTag is the logical counter of the hybrid logical clock value.
+	LogicalTag = "hlc_logical"
+
+	// NodeTag identifies the data node that stamped the envelope. It breaks
+	// ties between nodes so the order is total.
+	NodeTag = "hlc_node"
+)
+
+// Timestamp is a hybrid logical clock value.
+type Timestamp struct {
+	Wall    int64
+	Logical int64
+	Node    string
+}
+
+// Less reports whether t is ordered before other.
+func (t Timestamp) Less(other Timestamp) bool {
+	if t.Wall != other.Wall {
+		return t.Wall < other.Wall
+	}
+
+	if t.Logical != other.Logical {
+		return t.Logical < other.Logical
+	}
+
+	return t.Node < other.Node
+}
+
+// String returns a representation that sorts lexically in the same order
+// as Less for non-negative values.
+func (t Timestamp) String() string {
+	return fmt.Sprintf("%020d:%010d:%s", t.Wall, t.Logical, t.Node)
+}
+
+// Clock is a hybrid logical clock. Its values never go backwards and are
+// always ahead of any value it has observed.
+type Clock struct {
+	node string
+	now  func() time.Time
+
+	mu   sync.Mutex
+	last Timestamp
+}
+
+func NewClock(node string, now func() time.Time) *Clock {
+	return &Clock{
+		node: node,
+		now:  now,
+		last: Timestamp{Node: node},
+	}
+}
+
+// Now returns the next value of the clock.
+func (c *Clock) Now() Timestamp {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.tick(Timestamp{})
+}
+
+// Update moves the clock past a value that was observed elsewhere and
+// returns the next value.
+func (c *Clock) Update(remote Timestamp) Timestamp {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.tick(remote)
+}
+
+func (c *Clock) tick(remote Timestamp) Timestamp {
+	wall := c.now().UnixNano()
+	last := c.last
+
+	switch {
+	case wall > last.Wall && wall > remote.Wall:
+		c.last = Timestamp{Wall: wall}
+	case last.Wall == remote.Wall:
+		c.last = Timestamp{Wall: last.Wall, Logical: maxInt64(last.Logical, remote.Logical) + 1}
+	case last.Wall > remote.Wall:
+		c.last = Timestamp{Wall: last.Wall, Logical: last.Logical + 1}
+	default:
+		c.last = Timestamp{Wall: remote.Wall, Logical: remote.Logical + 1}
+	}
+	c.last.Node = c.node
+
+	return c.last
+}
+
+// Stamp returns a copy of the envelope with the next clock value. If the
+// envelope already carries a value (e.g. it was read and written again),
+// the clock is moved past it first so the order agrees with causality.
+func (c *Clock) Stamp(e *v2.Envelope) *v2.Envelope {
+	var t Timestamp
+	if remote, ok := Parse(e); ok {
+		t = c.Update(remote)
+	} else {
+		t = c.Now()
+	}
+
+	stamped := *e
+	stamped.Tags = make(map[string]*v2.Value, len(e.Tags)+3)
+	for k, v := range e.Tags {
+		stamped.Tags[k] = v
+	}
+	stamped.Tags[WallTag] = &v2.Value{Data: &v2.Value_Integer{Integer: t.Wall}}
+	stamped.Tags[LogicalTag] = &v2.Value{Data: &v2.Value_Integer{Integer: t.Logical}}
+	stamped.Tags[NodeTag] = &v2.Value{Data: &v2.Value_Text{Text: t.Node}}
+
+	return &stamped
+}
+
+// Parse returns the clock value of an envelope. ok is false if the
+// envelope was not stamped.
+func Parse(e *v2.Envelope) (t Timestamp, ok bool) {
+	wall, ok := e.GetTags()[WallTag].GetData().(*v2.Value_Integer)
+	if !ok {
+		return Timestamp{}, false
+	}
+
+	logical, ok := e.GetTags()[LogicalTag].GetData().(*v2.Value_Integer)
+	if !ok {
+		return Timestamp{}, false
+	}
+
+	return Timestamp{
+		Wall:    wall.Integer,
+		Logical: logical.Integer,
+		Node:    e.GetTags()[NodeTag].GetText(),
+	}, true
+}
+
+// Of returns the clock value of an envelope. Envelopes that were not
+// stamped use their own timestamp.
+func Of(e *v2.Envelope) Timestamp {
+	if t, ok := Parse(e); ok {
+		return t
+	}
+
+	return Timestamp{Wall: e.GetTimestamp()}
+}
+
+// Sort orders the envelopes by their clock value. Envelopes with the same
+// value keep their relative order.
+func Sort(envelopes []*v2.Envelope) {
+	sort.SliceStable(envelopes, func(i, j int) bool {
+		return Of(envelopes[i]).Less(Of(envelopes[j]))
+	})
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
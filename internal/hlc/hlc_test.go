@@ -0,0 +1,122 @@
+package hlc_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/hlc"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TC struct {
+	*testing.T
+	now   *time.Time
+	clock *hlc.Clock
+}
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TC {
+		now := time.Unix(0, 100)
+		return TC{
+			T:   t,
+			now: &now,
+			clock: hlc.NewClock("a", func() time.Time {
+				return now
+			}),
+		}
+	})
+
+	o.Spec("it uses the wall clock when it moves forward", func(t TC) {
+		t1 := t.clock.Now()
+		*t.now = time.Unix(0, 200)
+		t2 := t.clock.Now()
+
+		Expect(t, t1).To(Equal(hlc.Timestamp{Wall: 100, Node: "a"}))
+		Expect(t, t2).To(Equal(hlc.Timestamp{Wall: 200, Node: "a"}))
+	})
+
+	o.Spec("it increments the logical counter when the wall clock stalls", func(t TC) {
+		t1 := t.clock.Now()
+		*t.now = time.Unix(0, 50)
+		t2 := t.clock.Now()
+
+		Expect(t, t1.Less(t2)).To(BeTrue())
+		Expect(t, t2).To(Equal(hlc.Timestamp{Wall: 100, Logical: 1, Node: "a"}))
+	})
+
+	o.Spec("it moves past remote values", func(t TC) {
+		t1 := t.clock.Update(hlc.Timestamp{Wall: 500, Logical: 3, Node: "b"})
+		t2 := t.clock.Now()
+
+		Expect(t, t1).To(Equal(hlc.Timestamp{Wall: 500, Logical: 4, Node: "a"}))
+		Expect(t, t2).To(Equal(hlc.Timestamp{Wall: 500, Logical: 5, Node: "a"}))
+	})
+
+	o.Spec("it stamps envelopes after their existing value", func(t TC) {
+		remote := hlc.NewClock("b", func() time.Time {
+			return time.Unix(0, 900)
+		})
+		e := remote.Stamp(&v2.Envelope{SourceId: "some-id"})
+
+		stamped := t.clock.Stamp(e)
+		before, _ := hlc.Parse(e)
+		after, ok := hlc.Parse(stamped)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, before.Less(after)).To(BeTrue())
+		Expect(t, after.Node).To(Equal("a"))
+	})
+}
+
+func TestSort(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it orders by wall, logical and node", func(t *testing.T) {
+		a := stamp(hlc.Timestamp{Wall: 2, Logical: 0, Node: "a"})
+		b := stamp(hlc.Timestamp{Wall: 1, Logical: 1, Node: "b"})
+		c := stamp(hlc.Timestamp{Wall: 1, Logical: 1, Node: "a"})
+		d := &v2.Envelope{Timestamp: 1}
+
+		envelopes := []*v2.Envelope{a, b, c, d}
+		hlc.Sort(envelopes)
+		Expect(t, envelopes).To(Equal([]*v2.Envelope{d, c, b, a}))
+	})
+
+	o.Spec("it has a string form that sorts the same way", func(t *testing.T) {
+		a := hlc.Timestamp{Wall: 9, Logical: 10, Node: "a"}
+		b := hlc.Timestamp{Wall: 10, Logical: 1, Node: "a"}
+		Expect(t, a.Less(b)).To(BeTrue())
+		Expect(t, a.String() < b.String()).To(BeTrue())
+	})
+}
+
+func stamp(t hlc.Timestamp) *v2.Envelope {
+	return &v2.Envelope{
+		Tags: map[string]*v2.Value{
+			hlc.WallTag:    {Data: &v2.Value_Integer{Integer: t.Wall}},
+			hlc.LogicalTag: {Data: &v2.Value_Integer{Integer: t.Logical}},
+			hlc.NodeTag:    {Data: &v2.Value_Text{Text: t.Node}},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package ingest
+
+import (
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/hlc"
+)
+
+const (
+	// TimestampTag is the time (in nanoseconds) the data node received the
+	// envelope.
+	TimestampTag = "ingest_timestamp"
+
+	// ProducerTimestampTag holds the producer's timestamp when it was
+	// replaced by the ingest timestamp.
+	ProducerTimestampTag = "producer_timestamp"
+
+	// SkewTag is the difference (in nanoseconds) between the producer's
+	// timestamp and the ingest timestamp.
+	SkewTag = "clock_skew"
+)
+
+// Timestamp returns the ingest timestamp of the envelope. Envelopes written
+// before ingest timestamps were recorded fall back to their own timestamp.
+func Timestamp(e *v2.Envelope) int64 {
+	if v, ok := e.GetTags()[TimestampTag].GetData().(*v2.Value_Integer); ok {
+		return v.Integer
+	}
+
+	return e.GetTimestamp()
+}
+
+// Strip returns a copy of the envelope without the tags added on ingest.
+func Strip(e *v2.Envelope) *v2.Envelope {
+	stripped := *e
+	stripped.Tags = make(map[string]*v2.Value, len(e.Tags))
+	for k, v := range e.Tags {
+		switch k {
+		case TimestampTag, ProducerTimestampTag, SkewTag,
+			hlc.WallTag, hlc.LogicalTag, hlc.NodeTag:
+			continue
+		}
+		stripped.Tags[k] = v
+	}
+
+	if v, ok := e.Tags[ProducerTimestampTag].GetData().(*v2.Value_Integer); ok {
+		stripped.Timestamp = v.Integer
+	}
+
+	return &stripped
+}
@@ -0,0 +1,62 @@
+package ingest_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/ingest"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestIngest(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.Spec("it returns the ingest timestamp", func(t *testing.T) {
+		e := &v2.Envelope{
+			Timestamp: 99,
+			Tags: map[string]*v2.Value{
+				ingest.TimestampTag: {Data: &v2.Value_Integer{Integer: 101}},
+			},
+		}
+		Expect(t, ingest.Timestamp(e)).To(Equal(int64(101)))
+	})
+
+	o.Spec("it falls back to the envelope timestamp", func(t *testing.T) {
+		e := &v2.Envelope{Timestamp: 99}
+		Expect(t, ingest.Timestamp(e)).To(Equal(int64(99)))
+	})
+
+	o.Spec("it strips the ingest tags and restores the producer timestamp", func(t *testing.T) {
+		e := &v2.Envelope{
+			Timestamp: 101,
+			Tags: map[string]*v2.Value{
+				"a":                         {Data: &v2.Value_Text{Text: "b"}},
+				ingest.TimestampTag:         {Data: &v2.Value_Integer{Integer: 101}},
+				ingest.ProducerTimestampTag: {Data: &v2.Value_Integer{Integer: 99}},
+				ingest.SkewTag:              {Data: &v2.Value_Integer{Integer: -2}},
+			},
+		}
+
+		stripped := ingest.Strip(e)
+		Expect(t, stripped.Timestamp).To(Equal(int64(99)))
+		Expect(t, stripped.Tags).To(HaveLen(1))
+		Expect(t, e.Tags).To(HaveLen(4))
+	})
+}
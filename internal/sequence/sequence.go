@@ -0,0 +1,99 @@
+package sequence
+
+import (
+	"sync"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/hashing"
+)
+
+const (
+	// EpochTag identifies the producer that stamped an envelope. A
+	// producer picks a new epoch every time it starts.
+	EpochTag = "producer_epoch"
+
+	// SequenceTag is the per stream sequence number within an epoch. It
+	// starts at 1.
+	SequenceTag = "producer_sequence"
+
+	// StreamTag is set on the envelopes of hot sources. It holds the
+	// secondary routing key, every key is routed to its own sub-range and
+	// is sequenced separately.
+	StreamTag = "producer_stream"
+)
+
+type stream struct {
+	sourceID string
+	key      string
+}
+
+// Stamper stamps envelopes with a monotonic sequence number per source. Hot
+// sources get a sequence per secondary routing key.
+type Stamper struct {
+	epoch      int64
+	hotSources map[string]string
+
+	mu        sync.Mutex
+	sequences map[stream]uint64
+}
+
+// NewStamper returns a Stamper for the given epoch. hotSources maps a
+// source ID to the tag that is used as its secondary routing key. It must
+// match the hot sources used to route the envelopes.
+func NewStamper(epoch int64, hotSources map[string]string) *Stamper {
+	return &Stamper{
+		epoch:      epoch,
+		hotSources: hotSources,
+		sequences:  make(map[stream]uint64),
+	}
+}
+
+// Stamp returns a copy of the envelope with the producer epoch and the next
+// sequence number for its source.
+func (s *Stamper) Stamp(e *v2.Envelope) *v2.Envelope {
+	st := stream{sourceID: e.SourceId}
+	tag, hot := s.hotSources[e.SourceId]
+	if hot {
+		st.key = hashing.TagKey(e.Tags[tag])
+	}
+
+	s.mu.Lock()
+	s.sequences[st]++
+	seq := s.sequences[st]
+	s.mu.Unlock()
+
+	stamped := *e
+	stamped.Tags = make(map[string]*v2.Value, len(e.Tags)+3)
+	for k, v := range e.Tags {
+		stamped.Tags[k] = v
+	}
+	stamped.Tags[EpochTag] = &v2.Value{Data: &v2.Value_Integer{Integer: s.epoch}}
+	stamped.Tags[SequenceTag] = &v2.Value{Data: &v2.Value_Integer{Integer: int64(seq)}}
+	if hot {
+		stamped.Tags[StreamTag] = &v2.Value{Data: &v2.Value_Text{Text: st.key}}
+	}
+
+	return &stamped
+}
+
+// Parse returns the producer epoch and sequence number of an envelope. ok
+// is false if the envelope was not stamped.
+func Parse(e *v2.Envelope) (epoch int64, seq uint64, ok bool) {
+	epochValue, ok := e.Tags[EpochTag].GetData().(*v2.Value_Integer)
+	if !ok {
+		return 0, 0, false
+	}
+
+	seqValue, ok := e.Tags[SequenceTag].GetData().(*v2.Value_Integer)
+	if !ok || seqValue.Integer <= 0 {
+		return 0, 0, false
+	}
+
+	return epochValue.Integer, uint64(seqValue.Integer), true
+}
+
+// Stream returns the stream of an envelope within its source. It is empty
+// for every source that is not hot.
+func Stream(e *v2.Envelope) string {
+	return e.Tags[StreamTag].GetText()
+}
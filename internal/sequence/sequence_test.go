@@ -0,0 +1,106 @@
+package sequence_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/sequence"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestStamper(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) (*testing.T, *sequence.Stamper) {
+		return t, sequence.NewStamper(99, map[string]string{"hot": "instance"})
+	})
+
+	o.Spec("it stamps a sequence per source", func(t *testing.T, s *sequence.Stamper) {
+		a1 := s.Stamp(&v2.Envelope{SourceId: "a"})
+		b1 := s.Stamp(&v2.Envelope{SourceId: "b"})
+		a2 := s.Stamp(&v2.Envelope{SourceId: "a"})
+
+		epoch, seq, ok := sequence.Parse(a1)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, epoch).To(Equal(int64(99)))
+		Expect(t, seq).To(Equal(uint64(1)))
+
+		_, seq, _ = sequence.Parse(b1)
+		Expect(t, seq).To(Equal(uint64(1)))
+
+		_, seq, _ = sequence.Parse(a2)
+		Expect(t, seq).To(Equal(uint64(2)))
+	})
+
+	o.Spec("it stamps a sequence per stream of a hot source", func(t *testing.T, s *sequence.Stamper) {
+		hot := func(instance string) *v2.Envelope {
+			return s.Stamp(&v2.Envelope{
+				SourceId: "hot",
+				Tags: map[string]*v2.Value{
+					"instance": {Data: &v2.Value_Text{Text: instance}},
+				},
+			})
+		}
+		x1 := hot("x")
+		y1 := hot("y")
+		x2 := hot("x")
+
+		_, seq, _ := sequence.Parse(x1)
+		Expect(t, seq).To(Equal(uint64(1)))
+		_, seq, _ = sequence.Parse(y1)
+		Expect(t, seq).To(Equal(uint64(1)))
+		_, seq, _ = sequence.Parse(x2)
+		Expect(t, seq).To(Equal(uint64(2)))
+
+		Expect(t, sequence.Stream(x1)).To(Equal("x"))
+		Expect(t, sequence.Stream(x1)).To(Equal(sequence.Stream(x2)))
+		Expect(t, sequence.Stream(x1)).To(Not(Equal(sequence.Stream(y1))))
+		Expect(t, sequence.Stream(s.Stamp(&v2.Envelope{SourceId: "a"}))).To(Equal(""))
+	})
+
+	o.Spec("it does not modify the given envelope", func(t *testing.T, s *sequence.Stamper) {
+		e := &v2.Envelope{
+			SourceId: "a",
+			Tags: map[string]*v2.Value{
+				"some-tag": {Data: &v2.Value_Text{Text: "some-value"}},
+			},
+		}
+		stamped := s.Stamp(e)
+
+		Expect(t, e.Tags).To(HaveLen(1))
+		Expect(t, stamped.Tags).To(HaveLen(3))
+		Expect(t, stamped.Tags).To(HaveKey("some-tag"))
+	})
+
+	o.Spec("it does not parse an envelope that was not stamped", func(t *testing.T, s *sequence.Stamper) {
+		_, _, ok := sequence.Parse(&v2.Envelope{SourceId: "a"})
+		Expect(t, ok).To(BeFalse())
+	})
+}
+
+func stamped(sourceID string, epoch int64, seq uint64) *v2.Envelope {
+	return &v2.Envelope{
+		SourceId: sourceID,
+		Tags: map[string]*v2.Value{
+			sequence.EpochTag:    {Data: &v2.Value_Integer{Integer: epoch}},
+			sequence.SequenceTag: {Data: &v2.Value_Integer{Integer: int64(seq)}},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package sequence
+
+import (
+	"sort"
+	"sync"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+// SourceStats describes what a Tracker has seen from one producer epoch of
+// a source stream. Only hot sources have more than one stream.
+type SourceStats struct {
+	SourceID     string
+	Stream       string
+	Epoch        int64
+	LastSequence uint64
+
+	// Gaps is the number of sequence numbers that were skipped.
+	Gaps uint64
+
+	// Reorders is the number of envelopes that arrived with a sequence
+	// number that was not larger than the last one.
+	Reorders uint64
+}
+
+// maxEpochs is the number of producer epochs kept per source stream. A
+// producer picks a new epoch when it restarts, the older epoch is kept
+// while the old producer may still be writing.
+const maxEpochs = 2
+
+type producer struct {
+	sourceID string
+	stream   string
+	epoch    int64
+}
+
+type streamKey struct {
+	sourceID string
+	stream   string
+}
+
+// Tracker keeps track of the last sequence number seen per source stream
+// and producer epoch. It should observe the envelopes after duplicates
+// were dropped. Only the latest epochs of each stream are kept, the gaps
+// and reorders of older epochs are still part of the totals.
+type Tracker struct {
+	mu       sync.Mutex
+	stats    map[producer]*SourceStats
+	epochs   map[streamKey][]int64
+	gaps     uint64
+	reorders uint64
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		stats:  make(map[producer]*SourceStats),
+		epochs: make(map[streamKey][]int64),
+	}
+}
+
+// Observe records the sequence number of an envelope. Envelopes that were
+// not stamped are ignored.
+func (t *Tracker) Observe(e *v2.Envelope) {
+	epoch, seq, ok := Parse(e)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p := producer{sourceID: e.SourceId, stream: Stream(e), epoch: epoch}
+	s, ok := t.stats[p]
+	if !ok {
+		if !t.addEpoch(p) {
+			return
+		}
+		s = &SourceStats{SourceID: e.SourceId, Stream: p.stream, Epoch: epoch}
+		t.stats[p] = s
+	}
+
+	switch {
+	case seq <= s.LastSequence:
+		s.Reorders++
+		t.reorders++
+	default:
+		s.Gaps += seq - s.LastSequence - 1
+		t.gaps += seq - s.LastSequence - 1
+		s.LastSequence = seq
+	}
+}
+
+// addEpoch records a new epoch of the producer's stream and drops the
+// oldest epoch once there are more than maxEpochs. It returns false if the
+// epoch is older than every epoch that is kept. It must be called with the
+// lock held.
+func (t *Tracker) addEpoch(p producer) bool {
+	key := streamKey{sourceID: p.sourceID, stream: p.stream}
+	epochs := t.epochs[key]
+	if len(epochs) >= maxEpochs && p.epoch < epochs[0] {
+		return false
+	}
+
+	i := sort.Search(len(epochs), func(i int) bool { return epochs[i] > p.epoch })
+	epochs = append(epochs, 0)
+	copy(epochs[i+1:], epochs[i:])
+	epochs[i] = p.epoch
+
+	if len(epochs) > maxEpochs {
+		delete(t.stats, producer{sourceID: p.sourceID, stream: p.stream, epoch: epochs[0]})
+		epochs = epochs[1:]
+	}
+	t.epochs[key] = epochs
+
+	return true
+}
+
+// Stats returns the stats for the given source. An empty source ID returns
+// the stats for every source.
+func (t *Tracker) Stats(sourceID string) []SourceStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var result []SourceStats
+	for p, s := range t.stats {
+		if sourceID != "" && p.sourceID != sourceID {
+			continue
+		}
+		result = append(result, *s)
+	}
+
+	return result
+}
+
+// Totals returns the gaps and reorders summed over every source.
+func (t *Tracker) Totals() (gaps, reorders uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.gaps, t.reorders
+}
@@ -0,0 +1,93 @@
+package sequence_test
+
+import (
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/sequence"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) (*testing.T, *sequence.Tracker) {
+		return t, sequence.NewTracker()
+	})
+
+	o.Spec("it counts gaps and reorders", func(t *testing.T, tr *sequence.Tracker) {
+		for _, seq := range []uint64{1, 2, 5, 4, 6, 9} {
+			tr.Observe(stamped("a", 1, seq))
+		}
+
+		Expect(t, tr.Stats("a")).To(Equal([]sequence.SourceStats{
+			{SourceID: "a", Epoch: 1, LastSequence: 9, Gaps: 4, Reorders: 1},
+		}))
+
+		gaps, reorders := tr.Totals()
+		Expect(t, gaps).To(Equal(uint64(4)))
+		Expect(t, reorders).To(Equal(uint64(1)))
+	})
+
+	o.Spec("it tracks each epoch separately", func(t *testing.T, tr *sequence.Tracker) {
+		tr.Observe(stamped("a", 1, 1))
+		tr.Observe(stamped("a", 2, 1))
+		tr.Observe(stamped("b", 1, 1))
+
+		Expect(t, tr.Stats("a")).To(HaveLen(2))
+		Expect(t, tr.Stats("")).To(HaveLen(3))
+
+		gaps, reorders := tr.Totals()
+		Expect(t, gaps).To(Equal(uint64(0)))
+		Expect(t, reorders).To(Equal(uint64(0)))
+	})
+
+	o.Spec("it only keeps the latest epochs of a source", func(t *testing.T, tr *sequence.Tracker) {
+		tr.Observe(stamped("a", 1, 1))
+		tr.Observe(stamped("a", 1, 3))
+		tr.Observe(stamped("a", 2, 1))
+		tr.Observe(stamped("a", 3, 1))
+		tr.Observe(stamped("a", 1, 5))
+
+		var epochs []int64
+		for _, s := range tr.Stats("a") {
+			epochs = append(epochs, s.Epoch)
+		}
+		Expect(t, epochs).To(HaveLen(2))
+		Expect(t, epochs).To(Contain(int64(2), int64(3)))
+
+		gaps, _ := tr.Totals()
+		Expect(t, gaps).To(Equal(uint64(1)))
+	})
+
+	o.Spec("it tracks each stream of a hot source separately", func(t *testing.T, tr *sequence.Tracker) {
+		s := sequence.NewStamper(1, map[string]string{"a": "instance"})
+		for _, instance := range []string{"x", "y", "x", "y"} {
+			tr.Observe(s.Stamp(&v2.Envelope{
+				SourceId: "a",
+				Tags: map[string]*v2.Value{
+					"instance": {Data: &v2.Value_Text{Text: instance}},
+				},
+			}))
+		}
+
+		stats := tr.Stats("a")
+		Expect(t, stats).To(HaveLen(2))
+		for _, s := range stats {
+			Expect(t, s.Stream).To(Not(Equal("")))
+			Expect(t, s.LastSequence).To(Equal(uint64(2)))
+		}
+		gaps, reorders := tr.Totals()
+		Expect(t, gaps).To(Equal(uint64(0)))
+		Expect(t, reorders).To(Equal(uint64(0)))
+	})
+
+	o.Spec("it ignores envelopes that were not stamped", func(t *testing.T, tr *sequence.Tracker) {
+		tr.Observe(&v2.Envelope{SourceId: "a"})
+		Expect(t, tr.Stats("")).To(HaveLen(0))
+	})
+}
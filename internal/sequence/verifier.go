@@ -0,0 +1,112 @@
+package sequence
+
+import (
+	"sort"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+)
+
+// Range is an inclusive range of sequence numbers from one producer epoch
+// of a source stream.
+type Range struct {
+	Stream string
+	Epoch  int64
+	Low    uint64
+	High   uint64
+}
+
+type streamEpoch struct {
+	stream string
+	epoch  int64
+}
+
+// Verifier checks that every sequence number of a source was read back
+// exactly once. Every stream of a hot source is checked on its own.
+type Verifier struct {
+	seen map[streamEpoch]map[uint64]int
+}
+
+func NewVerifier() *Verifier {
+	return &Verifier{
+		seen: make(map[streamEpoch]map[uint64]int),
+	}
+}
+
+// Observe records an envelope that was read back. Envelopes that were not
+// stamped are ignored.
+func (v *Verifier) Observe(e *v2.Envelope) {
+	epoch, seq, ok := Parse(e)
+	if !ok {
+		return
+	}
+
+	se := streamEpoch{stream: Stream(e), epoch: epoch}
+	m, ok := v.seen[se]
+	if !ok {
+		m = make(map[uint64]int)
+		v.seen[se] = m
+	}
+	m[seq]++
+}
+
+// Missing returns the sequence numbers that were not read, up to the
+// largest one that was.
+func (v *Verifier) Missing() []Range {
+	var result []Range
+	for _, se := range v.streamEpochs() {
+		var last uint64
+		for _, seq := range v.sequences(se) {
+			if seq > last+1 {
+				result = append(result, Range{Stream: se.stream, Epoch: se.epoch, Low: last + 1, High: seq - 1})
+			}
+			last = seq
+		}
+	}
+
+	return result
+}
+
+// Duplicated returns the sequence numbers that were read more than once.
+func (v *Verifier) Duplicated() []Range {
+	var result []Range
+	for _, se := range v.streamEpochs() {
+		for _, seq := range v.sequences(se) {
+			if v.seen[se][seq] < 2 {
+				continue
+			}
+
+			if n := len(result); n > 0 && result[n-1].Stream == se.stream && result[n-1].Epoch == se.epoch && result[n-1].High+1 == seq {
+				result[n-1].High = seq
+				continue
+			}
+			result = append(result, Range{Stream: se.stream, Epoch: se.epoch, Low: seq, High: seq})
+		}
+	}
+
+	return result
+}
+
+func (v *Verifier) streamEpochs() []streamEpoch {
+	var result []streamEpoch
+	for se := range v.seen {
+		result = append(result, se)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].stream != result[j].stream {
+			return result[i].stream < result[j].stream
+		}
+		return result[i].epoch < result[j].epoch
+	})
+
+	return result
+}
+
+func (v *Verifier) sequences(se streamEpoch) []uint64 {
+	var seqs []uint64
+	for seq := range v.seen[se] {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	return seqs
+}
@@ -0,0 +1,69 @@
+package sequence_test
+
+import (
+	"testing"
+
+	v2 "github.com/poy/loggrebutterfly/api/loggregator/v2"
+	"github.com/poy/loggrebutterfly/internal/sequence"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) (*testing.T, *sequence.Verifier) {
+		return t, sequence.NewVerifier()
+	})
+
+	o.Spec("it reports missing sequence ranges", func(t *testing.T, v *sequence.Verifier) {
+		for _, seq := range []uint64{2, 3, 6, 7, 9} {
+			v.Observe(stamped("a", 1, seq))
+		}
+		v.Observe(stamped("a", 2, 1))
+
+		Expect(t, v.Missing()).To(Equal([]sequence.Range{
+			{Epoch: 1, Low: 1, High: 1},
+			{Epoch: 1, Low: 4, High: 5},
+			{Epoch: 1, Low: 8, High: 8},
+		}))
+	})
+
+	o.Spec("it reports duplicated sequence ranges", func(t *testing.T, v *sequence.Verifier) {
+		for _, seq := range []uint64{1, 2, 2, 3, 3, 4, 5, 5} {
+			v.Observe(stamped("a", 1, seq))
+		}
+
+		Expect(t, v.Duplicated()).To(Equal([]sequence.Range{
+			{Epoch: 1, Low: 2, High: 3},
+			{Epoch: 1, Low: 5, High: 5},
+		}))
+	})
+
+	o.Spec("it checks every stream of a hot source on its own", func(t *testing.T, v *sequence.Verifier) {
+		s := sequence.NewStamper(1, map[string]string{"a": "instance"})
+		for _, instance := range []string{"x", "y", "y", "x"} {
+			v.Observe(s.Stamp(&v2.Envelope{
+				SourceId: "a",
+				Tags: map[string]*v2.Value{
+					"instance": {Data: &v2.Value_Text{Text: instance}},
+				},
+			}))
+		}
+
+		Expect(t, v.Missing()).To(HaveLen(0))
+		Expect(t, v.Duplicated()).To(HaveLen(0))
+	})
+
+	o.Spec("it reports nothing for a complete source", func(t *testing.T, v *sequence.Verifier) {
+		for _, seq := range []uint64{1, 2, 3} {
+			v.Observe(stamped("a", 1, seq))
+		}
+
+		Expect(t, v.Missing()).To(HaveLen(0))
+		Expect(t, v.Duplicated()).To(HaveLen(0))
+	})
+}
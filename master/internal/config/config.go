@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/bradylove/envstruct"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 )
 
 type Config struct {
@@ -24,17 +25,28 @@ type Config struct {
 	BalancerInterval time.Duration `env:"BALANCER_INTERVAL"`
 	FillerInterval   time.Duration `env:"FILLER_INTERVAL"`
 
+	// DryRun makes the balancer and filler log what they would create
+	// instead of creating it.
+	DryRun              bool `env:"DRY_RUN"`
+	DecisionHistorySize int  `env:"DECISION_HISTORY_SIZE"`
+
 	TalariaBufferSize uint64 `env:"TALARIA_BUFFER_SIZE"`
+
+	// HashVersion is recorded in the name of every range that is created.
+	// The balancer and filler only manage ranges of this version.
+	HashVersion uint64 `env:"HASH_VERSION"`
 }
 
 func Load() Config {
 	conf := Config{
-		MaxRoutes:         10,
-		MinRoutes:         4,
-		BalancerInterval:  5 * time.Second,
-		FillerInterval:    time.Second,
-		PprofAddr:         "localhost:0",
-		TalariaBufferSize: 100,
+		MaxRoutes:           10,
+		MinRoutes:           4,
+		BalancerInterval:    5 * time.Second,
+		FillerInterval:      time.Second,
+		DecisionHistorySize: 1000,
+		PprofAddr:           "localhost:0",
+		TalariaBufferSize:   100,
+		HashVersion:         hashing.CurrentVersion,
 	}
 	if err := envstruct.Load(&conf); err != nil {
 		log.Fatalf("Unable to load config: %s", err)
@@ -44,6 +56,14 @@ func Load() Config {
 		log.Fatalf("DATA_NODE_EXTERNAL_ADDRS (%d) and TALARIA_NODE_ADDRS (%d) must have same count", len(conf.DataNodeExtAddrs), len(conf.TalariaNodeAddrs))
 	}
 
+	if conf.DecisionHistorySize <= 0 {
+		log.Fatalf("DECISION_HISTORY_SIZE (%d) must be positive", conf.DecisionHistorySize)
+	}
+
+	if _, err := hashing.New(conf.HashVersion, nil); err != nil {
+		log.Fatalf("Invalid HASH_VERSION: %s", err)
+	}
+
 	conf.TalariaNodeConverter = make(map[string]string)
 	for i := range conf.DataNodeAddrs {
 		conf.TalariaNodeConverter[conf.TalariaNodeAddrs[i]] = conf.DataNodeExtAddrs[i]
@@ -0,0 +1,3 @@
+package decisions
+
+//go:generate hel
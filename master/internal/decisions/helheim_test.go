@@ -0,0 +1,68 @@
+// This file was generated by github.com/nelsam/hel.  Do not
+// edit this code by hand unless you *really* know what you're
+// doing.  Expect any changes made manually to be overwritten
+// the next time hel regenerates this file.
+
+package decisions_test
+
+import "github.com/poy/petasos/router"
+
+type mockFileSystem struct {
+	ListCalled chan bool
+	ListOutput struct {
+		Files chan []string
+		Err   chan error
+	}
+	CreateCalled chan bool
+	CreateInput  struct {
+		File chan string
+	}
+	CreateOutput struct {
+		Err chan error
+	}
+}
+
+func newMockFileSystem() *mockFileSystem {
+	m := &mockFileSystem{}
+	m.ListCalled = make(chan bool, 100)
+	m.ListOutput.Files = make(chan []string, 100)
+	m.ListOutput.Err = make(chan error, 100)
+	m.CreateCalled = make(chan bool, 100)
+	m.CreateInput.File = make(chan string, 100)
+	m.CreateOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockFileSystem) List() (files []string, err error) {
+	m.ListCalled <- true
+	return <-m.ListOutput.Files, <-m.ListOutput.Err
+}
+func (m *mockFileSystem) Create(file string) (err error) {
+	m.CreateCalled <- true
+	m.CreateInput.File <- file
+	return <-m.CreateOutput.Err
+}
+
+type mockMetricsReader struct {
+	MetricsCalled chan bool
+	MetricsInput  struct {
+		File chan string
+	}
+	MetricsOutput struct {
+		Metric chan router.Metric
+		Err    chan error
+	}
+}
+
+func newMockMetricsReader() *mockMetricsReader {
+	m := &mockMetricsReader{}
+	m.MetricsCalled = make(chan bool, 100)
+	m.MetricsInput.File = make(chan string, 100)
+	m.MetricsOutput.Metric = make(chan router.Metric, 100)
+	m.MetricsOutput.Err = make(chan error, 100)
+	return m
+}
+func (m *mockMetricsReader) Metrics(file string) (metric router.Metric, err error) {
+	m.MetricsCalled <- true
+	m.MetricsInput.File <- file
+	return <-m.MetricsOutput.Metric, <-m.MetricsOutput.Err
+}
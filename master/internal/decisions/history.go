@@ -0,0 +1,77 @@
+package decisions
+
+import (
+	"sync"
+	"time"
+
+	"github.com/poy/petasos/router"
+)
+
+type Thresholds struct {
+	MinCount uint64
+	MaxCount uint64
+	Interval time.Duration
+}
+
+// Decision is a single action a maintainer took (or would have taken while
+// in dry-run mode) along with what it was looking at when it did.
+type Decision struct {
+	Timestamp  time.Time
+	Maintainer string
+	File       string
+	DryRun     bool
+	Err        error
+	Metrics    map[string]router.Metric
+	Thresholds Thresholds
+}
+
+// History is a bounded log of decisions. Once it is full, the oldest
+// decisions are dropped.
+type History struct {
+	mu        sync.Mutex
+	size      int
+	decisions []Decision
+}
+
+func NewHistory(size int) *History {
+	return &History{
+		size: size,
+	}
+}
+
+func (h *History) Add(d Decision) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.decisions = append(h.decisions, d)
+	if len(h.decisions) > h.size {
+		h.decisions = h.decisions[len(h.decisions)-h.size:]
+	}
+}
+
+// Decisions returns the decisions made by the given maintainer since start.
+// An empty maintainer matches every maintainer and a limit of 0 returns
+// every match. When limited, the most recent decisions are returned.
+func (h *History) Decisions(maintainer string, start time.Time, limit int) []Decision {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var results []Decision
+	for _, d := range h.decisions {
+		if maintainer != "" && d.Maintainer != maintainer {
+			continue
+		}
+
+		if d.Timestamp.Before(start) {
+			continue
+		}
+
+		results = append(results, d)
+	}
+
+	if limit > 0 && len(results) > limit {
+		results = results[len(results)-limit:]
+	}
+
+	return results
+}
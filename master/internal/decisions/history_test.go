@@ -0,0 +1,92 @@
+package decisions_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/poy/loggrebutterfly/master/internal/decisions"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	os.Exit(m.Run())
+}
+
+type TH struct {
+	*testing.T
+	h *decisions.History
+}
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	o.BeforeEach(func(t *testing.T) TH {
+		return TH{
+			T: t,
+			h: decisions.NewHistory(3),
+		}
+	})
+
+	o.Spec("it returns every decision", func(t TH) {
+		t.h.Add(decisions.Decision{File: "a", Maintainer: "balancer"})
+		t.h.Add(decisions.Decision{File: "b", Maintainer: "filler"})
+
+		results := t.h.Decisions("", time.Time{}, 0)
+		Expect(t, results).To(HaveLen(2))
+		Expect(t, results[0].File).To(Equal("a"))
+		Expect(t, results[1].File).To(Equal("b"))
+	})
+
+	o.Spec("it drops the oldest decisions once full", func(t TH) {
+		for _, f := range []string{"a", "b", "c", "d"} {
+			t.h.Add(decisions.Decision{File: f})
+		}
+
+		results := t.h.Decisions("", time.Time{}, 0)
+		Expect(t, results).To(HaveLen(3))
+		Expect(t, results[0].File).To(Equal("b"))
+		Expect(t, results[2].File).To(Equal("d"))
+	})
+
+	o.Spec("it filters by maintainer", func(t TH) {
+		t.h.Add(decisions.Decision{File: "a", Maintainer: "balancer"})
+		t.h.Add(decisions.Decision{File: "b", Maintainer: "filler"})
+
+		results := t.h.Decisions("filler", time.Time{}, 0)
+		Expect(t, results).To(HaveLen(1))
+		Expect(t, results[0].File).To(Equal("b"))
+	})
+
+	o.Spec("it filters by start time", func(t TH) {
+		t.h.Add(decisions.Decision{File: "a", Timestamp: time.Unix(0, 1)})
+		t.h.Add(decisions.Decision{File: "b", Timestamp: time.Unix(0, 3)})
+
+		results := t.h.Decisions("", time.Unix(0, 2), 0)
+		Expect(t, results).To(HaveLen(1))
+		Expect(t, results[0].File).To(Equal("b"))
+	})
+
+	o.Spec("it returns the most recent decisions when limited", func(t TH) {
+		for _, f := range []string{"a", "b", "c"} {
+			t.h.Add(decisions.Decision{File: f})
+		}
+
+		results := t.h.Decisions("", time.Time{}, 2)
+		Expect(t, results).To(HaveLen(2))
+		Expect(t, results[0].File).To(Equal("b"))
+		Expect(t, results[1].File).To(Equal("c"))
+	})
+}
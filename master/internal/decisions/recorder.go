@@ -0,0 +1,137 @@
+package decisions
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/poy/petasos/router"
+)
+
+type FileSystem interface {
+	List() (files []string, err error)
+	Create(file string) (err error)
+}
+
+type MetricsReader interface {
+	Metrics(file string) (metric router.Metric, err error)
+}
+
+// Recorder sits between a maintainer and the FileSystem and MetricsReader
+// it was given. It remembers the metrics the maintainer has read since it
+// last listed the files and writes each Create to the History. When dryRun
+// is set, the Create is logged and recorded but never reaches the
+// FileSystem. A dry-run Create is only recorded the first time, because
+// the maintainer asks for the same file every interval.
+type Recorder struct {
+	name       string
+	dryRun     bool
+	thresholds Thresholds
+	fs         FileSystem
+	reader     MetricsReader
+	history    *History
+
+	mu       sync.Mutex
+	snapshot map[string]router.Metric
+	pending  map[string]bool
+}
+
+func NewRecorder(
+	name string,
+	dryRun bool,
+	thresholds Thresholds,
+	fs FileSystem,
+	reader MetricsReader,
+	history *History,
+) *Recorder {
+	return &Recorder{
+		name:       name,
+		dryRun:     dryRun,
+		thresholds: thresholds,
+		fs:         fs,
+		reader:     reader,
+		history:    history,
+		snapshot:   make(map[string]router.Metric),
+		pending:    make(map[string]bool),
+	}
+}
+
+func (r *Recorder) List() (files []string, err error) {
+	r.mu.Lock()
+	r.snapshot = make(map[string]router.Metric)
+	r.mu.Unlock()
+
+	files, err = r.fs.List()
+	if err != nil {
+		return nil, err
+	}
+
+	// A pending dry-run file that now exists was created by someone else.
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, f := range files {
+		delete(r.pending, f)
+	}
+
+	return files, nil
+}
+
+func (r *Recorder) Metrics(file string) (metric router.Metric, err error) {
+	metric, err = r.reader.Metrics(file)
+	if err != nil {
+		return router.Metric{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.snapshot[file] = metric
+
+	return metric, nil
+}
+
+func (r *Recorder) Create(file string) (err error) {
+	if r.dryRun {
+		if !r.markPending(file) {
+			return nil
+		}
+		log.Printf("[DRY RUN] %s would create %s", r.name, file)
+	} else {
+		log.Printf("%s is creating %s", r.name, file)
+		err = r.fs.Create(file)
+	}
+
+	r.history.Add(Decision{
+		Timestamp:  time.Now(),
+		Maintainer: r.name,
+		File:       file,
+		DryRun:     r.dryRun,
+		Err:        err,
+		Metrics:    r.copySnapshot(),
+		Thresholds: r.thresholds,
+	})
+
+	return err
+}
+
+// markPending reports whether the dry-run file was not already pending.
+func (r *Recorder) markPending(file string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pending[file] {
+		return false
+	}
+	r.pending[file] = true
+	return true
+}
+
+func (r *Recorder) copySnapshot() map[string]router.Metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := make(map[string]router.Metric, len(r.snapshot))
+	for k, v := range r.snapshot {
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,143 @@
+package decisions_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/poy/loggrebutterfly/master/internal/decisions"
+	"github.com/poy/onpar"
+	. "github.com/poy/onpar/expect"
+	. "github.com/poy/onpar/matchers"
+	"github.com/poy/petasos/router"
+)
+
+type TR struct {
+	*testing.T
+	r                 *decisions.Recorder
+	history           *decisions.History
+	mockFileSystem    *mockFileSystem
+	mockMetricsReader *mockMetricsReader
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	o := onpar.New()
+	defer o.Run(t)
+
+	setup := func(dryRun bool) func(t *testing.T) TR {
+		return func(t *testing.T) TR {
+			mockFileSystem := newMockFileSystem()
+			mockMetricsReader := newMockMetricsReader()
+			history := decisions.NewHistory(10)
+
+			thresholds := decisions.Thresholds{
+				MinCount: 4,
+				MaxCount: 10,
+				Interval: time.Second,
+			}
+
+			return TR{
+				T:                 t,
+				r:                 decisions.NewRecorder("balancer", dryRun, thresholds, mockFileSystem, mockMetricsReader, history),
+				history:           history,
+				mockFileSystem:    mockFileSystem,
+				mockMetricsReader: mockMetricsReader,
+			}
+		}
+	}
+
+	o.Group("when not in dry-run mode", func() {
+		o.BeforeEach(setup(false))
+
+		o.Spec("it creates the file", func(t TR) {
+			close(t.mockFileSystem.CreateOutput.Err)
+
+			err := t.r.Create("some-file")
+			Expect(t, err == nil).To(BeTrue())
+			Expect(t, t.mockFileSystem.CreateInput.File).To(
+				Chain(Receive(), Equal("some-file")),
+			)
+		})
+
+		o.Spec("it records the decision with the metrics it saw", func(t TR) {
+			close(t.mockFileSystem.CreateOutput.Err)
+			close(t.mockFileSystem.ListOutput.Err)
+			close(t.mockMetricsReader.MetricsOutput.Err)
+			t.mockFileSystem.ListOutput.Files <- []string{"a"}
+			t.mockMetricsReader.MetricsOutput.Metric <- router.Metric{WriteCount: 99}
+
+			_, err := t.r.List()
+			Expect(t, err == nil).To(BeTrue())
+			_, err = t.r.Metrics("a")
+			Expect(t, err == nil).To(BeTrue())
+			t.r.Create("some-file")
+
+			results := t.history.Decisions("", time.Time{}, 0)
+			Expect(t, results).To(HaveLen(1))
+			Expect(t, results[0].Maintainer).To(Equal("balancer"))
+			Expect(t, results[0].File).To(Equal("some-file"))
+			Expect(t, results[0].DryRun).To(BeFalse())
+			Expect(t, results[0].Metrics).To(Equal(map[string]router.Metric{
+				"a": {WriteCount: 99},
+			}))
+			Expect(t, results[0].Thresholds.MaxCount).To(Equal(uint64(10)))
+		})
+
+		o.Spec("it records and returns the error from the file system", func(t TR) {
+			t.mockFileSystem.CreateOutput.Err <- fmt.Errorf("some-error")
+
+			err := t.r.Create("some-file")
+			Expect(t, err == nil).To(BeFalse())
+
+			results := t.history.Decisions("", time.Time{}, 0)
+			Expect(t, results).To(HaveLen(1))
+			Expect(t, results[0].Err == nil).To(BeFalse())
+		})
+
+		o.Spec("it forgets the metrics after listing", func(t TR) {
+			close(t.mockFileSystem.CreateOutput.Err)
+			close(t.mockFileSystem.ListOutput.Err)
+			close(t.mockMetricsReader.MetricsOutput.Err)
+			t.mockFileSystem.ListOutput.Files <- []string{"a"}
+			t.mockFileSystem.ListOutput.Files <- []string{"a"}
+			t.mockMetricsReader.MetricsOutput.Metric <- router.Metric{WriteCount: 99}
+
+			t.r.List()
+			t.r.Metrics("a")
+			t.r.List()
+			t.r.Create("some-file")
+
+			results := t.history.Decisions("", time.Time{}, 0)
+			Expect(t, results).To(HaveLen(1))
+			Expect(t, results[0].Metrics).To(HaveLen(0))
+		})
+	})
+
+	o.Group("when in dry-run mode", func() {
+		o.BeforeEach(setup(true))
+
+		o.Spec("it does not create the file", func(t TR) {
+			err := t.r.Create("some-file")
+			Expect(t, err == nil).To(BeTrue())
+			Expect(t, len(t.mockFileSystem.CreateCalled)).To(Equal(0))
+		})
+
+		o.Spec("it records the decision as a dry-run", func(t TR) {
+			t.r.Create("some-file")
+
+			results := t.history.Decisions("", time.Time{}, 0)
+			Expect(t, results).To(HaveLen(1))
+			Expect(t, results[0].DryRun).To(BeTrue())
+		})
+
+		o.Spec("it only records a repeated dry-run once", func(t TR) {
+			t.r.Create("some-file")
+			t.r.Create("some-file")
+			t.r.Create("some-other-file")
+
+			results := t.history.Decisions("", time.Time{}, 0)
+			Expect(t, results).To(HaveLen(2))
+		})
+	})
+}
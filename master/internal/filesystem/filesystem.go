@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/poy/loggrebutterfly/internal/hashing"
 	pb "github.com/poy/talaria/api/v1"
 	"google.golang.org/grpc"
 )
@@ -13,16 +14,20 @@ type FileSystem struct {
 	schedClient       pb.SchedulerClient
 	nodeAddrConverter map[string]string
 	bufferSize        uint64
+	hashVersion       uint64
 }
 
-func New(bufferSize uint64, addr string, nodeAddrConverter map[string]string) *FileSystem {
+func New(bufferSize uint64, addr string, nodeAddrConverter map[string]string, hashVersion uint64) *FileSystem {
 	return &FileSystem{
 		bufferSize:        bufferSize,
 		schedClient:       setupSchedClient(addr),
 		nodeAddrConverter: nodeAddrConverter,
+		hashVersion:       hashVersion,
 	}
 }
 
+// Create creates a buffer for the file. The name is used as is, see
+// Stamper for recording the hash version.
 func (f *FileSystem) Create(file string) (err error) {
 	ctx, _ := context.WithTimeout(context.Background(), 5*time.Second)
 	_, err = f.schedClient.Create(ctx, &pb.CreateInfo{
@@ -32,6 +37,9 @@ func (f *FileSystem) Create(file string) (err error) {
 	return err
 }
 
+// List returns the files for the configured hash version. Ranges from
+// other versions are left alone so a new version gets its own set of
+// ranges while the old ones are still readable.
 func (f *FileSystem) List() (files []string, err error) {
 	m, err := f.Routes()
 	if err != nil {
@@ -42,7 +50,7 @@ func (f *FileSystem) List() (files []string, err error) {
 		files = append(files, name)
 	}
 
-	return files, nil
+	return hashing.FilterVersion(files, f.hashVersion), nil
 }
 
 func (f *FileSystem) Routes() (routes map[string]string, err error) {
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/poy/eachers/testhelpers"
+	"github.com/poy/loggrebutterfly/internal/hashing"
 	"github.com/poy/loggrebutterfly/master/internal/filesystem"
 	"github.com/poy/onpar"
 	pb "github.com/poy/talaria/api/v1"
@@ -61,6 +62,18 @@ func TestFileSystemCreate(t *testing.T) {
 				})),
 			)
 		})
+
+		o.Spec("it records the hash version in range names", func(t TF) {
+			err := filesystem.NewStamper(t.fs, hashing.V1).Create(`{"low":0,"high":9,"term":1}`)
+			Expect(t, err == nil).To(BeTrue())
+
+			Expect(t, t.mockSchedulerServer.CreateInput.Arg1).To(
+				Chain(Receive(), Equal(&pb.CreateInfo{
+					Name:       `{"low":0,"high":9,"term":1,"hash_version":1}`,
+					BufferSize: 99,
+				})),
+			)
+		})
 	})
 }
 
@@ -91,6 +104,27 @@ func TestFileSystemList(t *testing.T) {
 			Expect(t, files).To(Contain("a", "b", "c"))
 		})
 	})
+
+	o.Group("when scheduler has ranges from other hash versions", func() {
+		o.BeforeEach(func(t TF) TF {
+			testhelpers.AlwaysReturn(t.mockSchedulerServer.ListClusterInfoOutput.Ret0, &pb.ListResponse{
+				Info: []*pb.ClusterInfo{
+					{Name: `{"low":0,"high":9}`, Leader: "a"},
+					{Name: `{"low":0,"high":9,"hash_version":2}`, Leader: "b"},
+				},
+			})
+			close(t.mockSchedulerServer.ListClusterInfoOutput.Ret1)
+			return t
+		})
+
+		o.Spec("it only lists the buffers for its hash version", func(t TF) {
+			files, err := t.fs.List()
+			Expect(t, err == nil).To(BeTrue())
+
+			Expect(t, files).To(HaveLen(1))
+			Expect(t, files).To(Contain(`{"low":0,"high":9}`))
+		})
+	})
 }
 
 func TestFileSystemRoutes(t *testing.T) {
@@ -135,7 +169,7 @@ func setup(o *onpar.Onpar) {
 		return TF{
 			T:                   t,
 			mockSchedulerServer: mockSchedulerServer,
-			fs:                  filesystem.New(99, addr, m),
+			fs:                  filesystem.New(99, addr, m, hashing.V1),
 		}
 	})
 }
@@ -0,0 +1,31 @@
+package filesystem
+
+import "github.com/poy/loggrebutterfly/internal/hashing"
+
+type RangeCreator interface {
+	List() (files []string, err error)
+	Create(file string) (err error)
+}
+
+// Stamper records the hash version in the name of every file it creates.
+// It sits in front of the decision recorder so the history has the names
+// the ranges are created with.
+type Stamper struct {
+	creator     RangeCreator
+	hashVersion uint64
+}
+
+func NewStamper(c RangeCreator, hashVersion uint64) *Stamper {
+	return &Stamper{
+		creator:     c,
+		hashVersion: hashVersion,
+	}
+}
+
+func (s *Stamper) List() (files []string, err error) {
+	return s.creator.List()
+}
+
+func (s *Stamper) Create(file string) (err error) {
+	return s.creator.Create(hashing.StampVersion(file, s.hashVersion))
+}
@@ -20,6 +20,42 @@ type mockDataNodeServer struct {
 		Ret0 chan *intra.ReadMetricsResponse
 		Ret1 chan error
 	}
+	ReadSequenceStatsCalled chan bool
+	ReadSequenceStatsInput  struct {
+		Arg0 chan context.Context
+		Arg1 chan *intra.ReadSequenceStatsInfo
+	}
+	ReadSequenceStatsOutput struct {
+		Ret0 chan *intra.ReadSequenceStatsResponse
+		Ret1 chan error
+	}
+	ReadDeadLettersCalled chan bool
+	ReadDeadLettersInput  struct {
+		Arg0 chan context.Context
+		Arg1 chan *intra.ReadDeadLettersInfo
+	}
+	ReadDeadLettersOutput struct {
+		Ret0 chan *intra.ReadDeadLettersResponse
+		Ret1 chan error
+	}
+	ReplayDeadLettersCalled chan bool
+	ReplayDeadLettersInput  struct {
+		Arg0 chan context.Context
+		Arg1 chan *intra.ReplayDeadLettersInfo
+	}
+	ReplayDeadLettersOutput struct {
+		Ret0 chan *intra.ReplayDeadLettersResponse
+		Ret1 chan error
+	}
+	ReadSkewStatsCalled chan bool
+	ReadSkewStatsInput  struct {
+		Arg0 chan context.Context
+		Arg1 chan *intra.ReadSkewStatsInfo
+	}
+	ReadSkewStatsOutput struct {
+		Ret0 chan *intra.ReadSkewStatsResponse
+		Ret1 chan error
+	}
 }
 
 func newMockDataNodeServer() *mockDataNodeServer {
@@ -29,6 +65,26 @@ func newMockDataNodeServer() *mockDataNodeServer {
 	m.ReadMetricsInput.Arg1 = make(chan *intra.ReadMetricsInfo, 100)
 	m.ReadMetricsOutput.Ret0 = make(chan *intra.ReadMetricsResponse, 100)
 	m.ReadMetricsOutput.Ret1 = make(chan error, 100)
+	m.ReadSequenceStatsCalled = make(chan bool, 100)
+	m.ReadSequenceStatsInput.Arg0 = make(chan context.Context, 100)
+	m.ReadSequenceStatsInput.Arg1 = make(chan *intra.ReadSequenceStatsInfo, 100)
+	m.ReadSequenceStatsOutput.Ret0 = make(chan *intra.ReadSequenceStatsResponse, 100)
+	m.ReadSequenceStatsOutput.Ret1 = make(chan error, 100)
+	m.ReadDeadLettersCalled = make(chan bool, 100)
+	m.ReadDeadLettersInput.Arg0 = make(chan context.Context, 100)
+	m.ReadDeadLettersInput.Arg1 = make(chan *intra.ReadDeadLettersInfo, 100)
+	m.ReadDeadLettersOutput.Ret0 = make(chan *intra.ReadDeadLettersResponse, 100)
+	m.ReadDeadLettersOutput.Ret1 = make(chan error, 100)
+	m.ReplayDeadLettersCalled = make(chan bool, 100)
+	m.ReplayDeadLettersInput.Arg0 = make(chan context.Context, 100)
+	m.ReplayDeadLettersInput.Arg1 = make(chan *intra.ReplayDeadLettersInfo, 100)
+	m.ReplayDeadLettersOutput.Ret0 = make(chan *intra.ReplayDeadLettersResponse, 100)
+	m.ReplayDeadLettersOutput.Ret1 = make(chan error, 100)
+	m.ReadSkewStatsCalled = make(chan bool, 100)
+	m.ReadSkewStatsInput.Arg0 = make(chan context.Context, 100)
+	m.ReadSkewStatsInput.Arg1 = make(chan *intra.ReadSkewStatsInfo, 100)
+	m.ReadSkewStatsOutput.Ret0 = make(chan *intra.ReadSkewStatsResponse, 100)
+	m.ReadSkewStatsOutput.Ret1 = make(chan error, 100)
 	return m
 }
 func (m *mockDataNodeServer) ReadMetrics(arg0 context.Context, arg1 *intra.ReadMetricsInfo) (*intra.ReadMetricsResponse, error) {
@@ -37,3 +93,27 @@ func (m *mockDataNodeServer) ReadMetrics(arg0 context.Context, arg1 *intra.ReadM
 	m.ReadMetricsInput.Arg1 <- arg1
 	return <-m.ReadMetricsOutput.Ret0, <-m.ReadMetricsOutput.Ret1
 }
+func (m *mockDataNodeServer) ReadSequenceStats(arg0 context.Context, arg1 *intra.ReadSequenceStatsInfo) (*intra.ReadSequenceStatsResponse, error) {
+	m.ReadSequenceStatsCalled <- true
+	m.ReadSequenceStatsInput.Arg0 <- arg0
+	m.ReadSequenceStatsInput.Arg1 <- arg1
+	return <-m.ReadSequenceStatsOutput.Ret0, <-m.ReadSequenceStatsOutput.Ret1
+}
+func (m *mockDataNodeServer) ReadDeadLetters(arg0 context.Context, arg1 *intra.ReadDeadLettersInfo) (*intra.ReadDeadLettersResponse, error) {
+	m.ReadDeadLettersCalled <- true
+	m.ReadDeadLettersInput.Arg0 <- arg0
+	m.ReadDeadLettersInput.Arg1 <- arg1
+	return <-m.ReadDeadLettersOutput.Ret0, <-m.ReadDeadLettersOutput.Ret1
+}
+func (m *mockDataNodeServer) ReplayDeadLetters(arg0 context.Context, arg1 *intra.ReplayDeadLettersInfo) (*intra.ReplayDeadLettersResponse, error) {
+	m.ReplayDeadLettersCalled <- true
+	m.ReplayDeadLettersInput.Arg0 <- arg0
+	m.ReplayDeadLettersInput.Arg1 <- arg1
+	return <-m.ReplayDeadLettersOutput.Ret0, <-m.ReplayDeadLettersOutput.Ret1
+}
+func (m *mockDataNodeServer) ReadSkewStats(arg0 context.Context, arg1 *intra.ReadSkewStatsInfo) (*intra.ReadSkewStatsResponse, error) {
+	m.ReadSkewStatsCalled <- true
+	m.ReadSkewStatsInput.Arg0 <- arg0
+	m.ReadSkewStatsInput.Arg1 <- arg1
+	return <-m.ReadSkewStatsOutput.Ret0, <-m.ReadSkewStatsOutput.Ret1
+}
@@ -5,6 +5,12 @@
 
 package server_test
 
+import (
+	"time"
+
+	"github.com/poy/loggrebutterfly/master/internal/decisions"
+)
+
 type mockLister struct {
 	RoutesCalled chan bool
 	RoutesOutput struct {
@@ -24,3 +30,32 @@ func (m *mockLister) Routes() (routes map[string]string, err error) {
 	m.RoutesCalled <- true
 	return <-m.RoutesOutput.Routes, <-m.RoutesOutput.Err
 }
+
+type mockDecisionReader struct {
+	DecisionsCalled chan bool
+	DecisionsInput  struct {
+		Maintainer chan string
+		Start      chan time.Time
+		Limit      chan int
+	}
+	DecisionsOutput struct {
+		Ret0 chan []decisions.Decision
+	}
+}
+
+func newMockDecisionReader() *mockDecisionReader {
+	m := &mockDecisionReader{}
+	m.DecisionsCalled = make(chan bool, 100)
+	m.DecisionsInput.Maintainer = make(chan string, 100)
+	m.DecisionsInput.Start = make(chan time.Time, 100)
+	m.DecisionsInput.Limit = make(chan int, 100)
+	m.DecisionsOutput.Ret0 = make(chan []decisions.Decision, 100)
+	return m
+}
+func (m *mockDecisionReader) Decisions(maintainer string, start time.Time, limit int) []decisions.Decision {
+	m.DecisionsCalled <- true
+	m.DecisionsInput.Maintainer <- maintainer
+	m.DecisionsInput.Start <- start
+	m.DecisionsInput.Limit <- limit
+	return <-m.DecisionsOutput.Ret0
+}
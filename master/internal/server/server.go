@@ -3,8 +3,10 @@ package server
 import (
 	"log"
 	"net"
+	"time"
 
 	pb "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/master/internal/decisions"
 
 	"golang.org/x/net/context"
 
@@ -15,14 +17,20 @@ type Lister interface {
 	Routes() (routes map[string]string, err error)
 }
 
+type DecisionReader interface {
+	Decisions(maintainer string, start time.Time, limit int) []decisions.Decision
+}
+
 type Server struct {
 	lister       Lister
+	decisions    DecisionReader
 	analystAddrs []string
 }
 
-func Start(addr string, analystAddrs []string, lister Lister) (actualAddr string, err error) {
+func Start(addr string, analystAddrs []string, lister Lister, decisions DecisionReader) (actualAddr string, err error) {
 	s := &Server{
 		lister:       lister,
+		decisions:    decisions,
 		analystAddrs: analystAddrs,
 	}
 
@@ -65,3 +73,43 @@ func (s *Server) Analysts(ctx context.Context, in *pb.AnalystsInfo) (*pb.Analyst
 
 	return &pb.AnalystsResponse{Analysts: info}, nil
 }
+
+func (s *Server) Decisions(ctx context.Context, in *pb.DecisionsInfo) (*pb.DecisionsResponse, error) {
+	ds := s.decisions.Decisions(in.Maintainer, time.Unix(0, in.Start), int(in.Limit))
+
+	var resp pb.DecisionsResponse
+	for _, d := range ds {
+		resp.Decisions = append(resp.Decisions, convertDecision(d))
+	}
+
+	return &resp, nil
+}
+
+func convertDecision(d decisions.Decision) *pb.Decision {
+	metrics := make(map[string]*pb.RangeMetric)
+	for file, m := range d.Metrics {
+		metrics[file] = &pb.RangeMetric{
+			WriteCount: m.WriteCount,
+			ErrCount:   m.ErrCount,
+		}
+	}
+
+	var errMsg string
+	if d.Err != nil {
+		errMsg = d.Err.Error()
+	}
+
+	return &pb.Decision{
+		Timestamp:  d.Timestamp.UnixNano(),
+		Maintainer: d.Maintainer,
+		File:       d.File,
+		DryRun:     d.DryRun,
+		Error:      errMsg,
+		Metrics:    metrics,
+		Thresholds: &pb.DecisionThresholds{
+			MinCount:   d.Thresholds.MinCount,
+			MaxCount:   d.Thresholds.MaxCount,
+			IntervalNs: int64(d.Thresholds.Interval),
+		},
+	}
+}
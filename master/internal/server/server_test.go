@@ -5,6 +5,7 @@ package server_test
 import (
 	"context"
 	"flag"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
@@ -14,10 +15,12 @@ import (
 	"google.golang.org/grpc"
 
 	pb "github.com/poy/loggrebutterfly/api/v1"
+	"github.com/poy/loggrebutterfly/master/internal/decisions"
 	"github.com/poy/loggrebutterfly/master/internal/server"
 	"github.com/poy/onpar"
 	. "github.com/poy/onpar/expect"
 	. "github.com/poy/onpar/matchers"
+	"github.com/poy/petasos/router"
 )
 
 func TestMain(m *testing.M) {
@@ -31,8 +34,9 @@ func TestMain(m *testing.M) {
 
 type TS struct {
 	*testing.T
-	masterClient pb.MasterClient
-	mockLister   *mockLister
+	masterClient       pb.MasterClient
+	mockLister         *mockLister
+	mockDecisionReader *mockDecisionReader
 }
 
 func TestServer(t *testing.T) {
@@ -42,17 +46,19 @@ func TestServer(t *testing.T) {
 
 	o.BeforeEach(func(t *testing.T) TS {
 		mockLister := newMockLister()
+		mockDecisionReader := newMockDecisionReader()
 		analysts := []string{
 			"analyst-a",
 			"analyst-b",
 		}
-		addr, err := server.Start("127.0.0.1:0", analysts, mockLister)
+		addr, err := server.Start("127.0.0.1:0", analysts, mockLister, mockDecisionReader)
 		Expect(t, err == nil).To(BeTrue())
 
 		return TS{
-			T:            t,
-			masterClient: fetchClient(addr),
-			mockLister:   mockLister,
+			T:                  t,
+			masterClient:       fetchClient(addr),
+			mockLister:         mockLister,
+			mockDecisionReader: mockDecisionReader,
 		}
 	})
 
@@ -94,6 +100,62 @@ func TestServer(t *testing.T) {
 		))
 		Expect(t, resp.Analysts[0].Addr).To(Not(Equal(resp.Analysts[1].Addr)))
 	})
+
+	o.Spec("it reports the decisions from the decision reader", func(t TS) {
+		t.mockDecisionReader.DecisionsOutput.Ret0 <- []decisions.Decision{
+			{
+				Timestamp:  time.Unix(0, 99),
+				Maintainer: "balancer",
+				File:       "some-file",
+				DryRun:     true,
+				Err:        fmt.Errorf("some-error"),
+				Metrics: map[string]router.Metric{
+					"some-other-file": {WriteCount: 101, ErrCount: 1},
+				},
+				Thresholds: decisions.Thresholds{
+					MinCount: 4,
+					MaxCount: 10,
+					Interval: time.Second,
+				},
+			},
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		resp, err := t.masterClient.Decisions(ctx, &pb.DecisionsInfo{
+			Maintainer: "balancer",
+			Start:      98,
+			Limit:      5,
+		})
+		Expect(t, err == nil).To(BeTrue())
+
+		Expect(t, t.mockDecisionReader.DecisionsInput.Maintainer).To(
+			Chain(Receive(), Equal("balancer")),
+		)
+		Expect(t, t.mockDecisionReader.DecisionsInput.Start).To(
+			Chain(Receive(), Equal(time.Unix(0, 98))),
+		)
+		Expect(t, t.mockDecisionReader.DecisionsInput.Limit).To(
+			Chain(Receive(), Equal(5)),
+		)
+
+		Expect(t, resp.Decisions).To(HaveLen(1))
+		Expect(t, resp.Decisions[0]).To(Equal(&pb.Decision{
+			Timestamp:  99,
+			Maintainer: "balancer",
+			File:       "some-file",
+			DryRun:     true,
+			Error:      "some-error",
+			Metrics: map[string]*pb.RangeMetric{
+				"some-other-file": {WriteCount: 101, ErrCount: 1},
+			},
+			Thresholds: &pb.DecisionThresholds{
+				MinCount:   4,
+				MaxCount:   10,
+				IntervalNs: int64(time.Second),
+			},
+		}))
+	})
 }
 
 func fetchClient(addr string) pb.MasterClient {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/poy/loggrebutterfly/master/internal/config"
+	"github.com/poy/loggrebutterfly/master/internal/decisions"
 	"github.com/poy/loggrebutterfly/master/internal/filesystem"
 	"github.com/poy/loggrebutterfly/master/internal/rangemetrics"
 	"github.com/poy/loggrebutterfly/master/internal/server"
@@ -20,21 +21,35 @@ func main() {
 	conf := config.Load()
 
 	metricsReader := rangemetrics.New(conf.DataNodeAddrs)
-	fs := filesystem.New(conf.TalariaBufferSize, conf.SchedulerAddr, conf.TalariaNodeConverter)
+	fs := filesystem.New(conf.TalariaBufferSize, conf.SchedulerAddr, conf.TalariaNodeConverter, conf.HashVersion)
 
-	maintainer.StartBalancer(metricsReader, fs,
+	if conf.DryRun {
+		log.Println("Running balancer and filler in dry-run mode")
+	}
+	history := decisions.NewHistory(conf.DecisionHistorySize)
+
+	balancer := decisions.NewRecorder("balancer", conf.DryRun, decisions.Thresholds{
+		MinCount: conf.MinRoutes,
+		MaxCount: conf.MaxRoutes,
+		Interval: conf.BalancerInterval,
+	}, fs, metricsReader, history)
+	maintainer.StartBalancer(balancer, filesystem.NewStamper(balancer, conf.HashVersion),
 		maintainer.WithMinCount(conf.MinRoutes),
 		maintainer.WithMaxCount(conf.MaxRoutes),
 		maintainer.WithBalancerInterval(conf.BalancerInterval),
 	)
 
-	maintainer.StartFiller(metricsReader, fs,
+	filler := decisions.NewRecorder("filler", conf.DryRun, decisions.Thresholds{
+		MinCount: conf.MinRoutes,
+		Interval: conf.FillerInterval,
+	}, fs, metricsReader, history)
+	maintainer.StartFiller(filler, filesystem.NewStamper(filler, conf.HashVersion),
 		maintainer.WithFillerInterval(conf.FillerInterval),
 		maintainer.WithFillerMinCount(conf.MinRoutes),
 	)
 
 	log.Printf("Starting server on %s", conf.Addr)
-	addr, err := server.Start(conf.Addr, conf.AnalystAddrs, fs)
+	addr, err := server.Start(conf.Addr, conf.AnalystAddrs, fs, history)
 	if err != nil {
 		log.Fatal("Unable to start server: %s", err)
 	}